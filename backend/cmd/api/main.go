@@ -22,23 +22,103 @@ func main() {
 
 	// リポジトリとサービスの初期化
 	eventRepo := repository.NewEventRepository(db)
-	eventService := service.NewEventService(eventRepo)
-	calendarService := service.NewCalendarService()
+	customHolidayRepo := repository.NewCustomHolidayRepository(db)
+	customHolidayService := service.NewCustomHolidayService(customHolidayRepo)
+	calendarService := service.NewCalendarService(customHolidayRepo, eventRepo)
+	eventService := service.NewEventService(eventRepo, calendarService)
+	eraService := service.NewEraService()
+	businessDayService := service.NewBusinessDayService(calendarService)
+	settlementService := service.NewSettlementService(calendarService)
+	fiscalService := service.NewFiscalService(calendarService)
+	dateInfoService := service.NewDateInfoService(calendarService)
+
+	// 内閣府公表の祝日データ（syukujitsu.csv）の読み込み
+	// 読み込めない場合は祝日法に基づく計算のみで動作する
+	if path := os.Getenv("SYUKUJITSU_CSV"); path != "" {
+		holidays, err := service.LoadSyukujitsuFile(path)
+		if err != nil {
+			log.Printf("Failed to load official holidays from %s: %v", path, err)
+		} else {
+			calendarService.SetOfficialHolidays(holidays)
+			from, to, _ := calendarService.OfficialHolidayRange()
+			log.Printf("Loaded %d official holidays (%d-%d) from %s", len(holidays), from, to, path)
+		}
+	}
+
+	// 年度の定義（JSON）の読み込み
+	// 組み込みの定義に追加し、同じIDの定義は置き換える
+	if path := os.Getenv("FISCAL_DEFINITIONS"); path != "" {
+		definitions, err := service.LoadFiscalDefinitionsFile(path)
+		if err != nil {
+			log.Printf("Failed to load fiscal year definitions from %s: %v", path, err)
+		}
+		for _, def := range definitions {
+			if err := fiscalService.RegisterDefinition(def); err != nil {
+				log.Printf("Invalid fiscal year definition %q in %s: %v", def.ID, path, err)
+				continue
+			}
+			log.Printf("Loaded fiscal year definition %q from %s", def.ID, path)
+		}
+	}
 
 	// ハンドラーの初期化
 	eventHandler := handler.NewEventHandler(eventService)
+	customHolidayHandler := handler.NewCustomHolidayHandler(customHolidayService)
 	calendarHandler := handler.NewCalendarHandler(calendarService)
+	eraHandler := handler.NewEraHandler(eraService)
+	businessDayHandler := handler.NewBusinessDayHandler(businessDayService)
+	settlementHandler := handler.NewSettlementHandler(settlementService)
+	fiscalHandler := handler.NewFiscalHandler(fiscalService)
+	dateInfoHandler := handler.NewDateInfoHandler(dateInfoService)
 
 	// ルーターの設定
 	r := mux.NewRouter()
 
 	// カレンダーAPI
+	r.HandleFunc("/api/calendar/{year:[0-9]+}", calendarHandler.GetYearCalendar).Methods("GET")
+	r.HandleFunc("/api/calendar/{year:[0-9]+}/week/{week:[0-9]+}", calendarHandler.GetWeekCalendar).Methods("GET")
 	r.HandleFunc("/api/calendar/{year:[0-9]+}/{month:[0-9]+}", calendarHandler.GetCalendar).Methods("GET")
+	r.HandleFunc("/api/calendar/{year:[0-9]+}/{month:[0-9]+}/{day:[0-9]+}", calendarHandler.GetDayCalendar).Methods("GET")
+	r.HandleFunc("/api/holidays", calendarHandler.GetHolidaysInRange).Methods("GET")
+	r.HandleFunc("/api/holidays/regions", calendarHandler.GetHolidayRegions).Methods("GET")
 	r.HandleFunc("/api/holidays/{year:[0-9]+}", calendarHandler.GetHolidays).Methods("GET")
+	r.HandleFunc("/api/holidays/{year:[0-9]+}/diff", calendarHandler.GetHolidayDiff).Methods("GET")
+	r.HandleFunc("/api/rekichu/{year:[0-9]+}", calendarHandler.GetRekichu).Methods("GET")
+
+	// 和暦API
+	r.HandleFunc("/api/eras", eraHandler.GetEras).Methods("GET")
+	r.HandleFunc("/api/wareki", eraHandler.ToJapaneseDate).Methods("GET")
+	r.HandleFunc("/api/wareki/gregorian", eraHandler.FromJapaneseDate).Methods("GET")
+
+	// 営業日API
+	r.HandleFunc("/api/business-days/check", businessDayHandler.CheckBusinessDay).Methods("GET")
+	r.HandleFunc("/api/business-days/add", businessDayHandler.AddBusinessDays).Methods("GET")
+	r.HandleFunc("/api/business-days/next", businessDayHandler.NextBusinessDay).Methods("GET")
+	r.HandleFunc("/api/business-days/previous", businessDayHandler.PreviousBusinessDay).Methods("GET")
+	r.HandleFunc("/api/business-days/count", businessDayHandler.CountBusinessDays).Methods("GET")
+
+	// 締め日・支払日API
+	r.HandleFunc("/api/settlements/{year:[0-9]+}", settlementHandler.GetSettlementSchedule).Methods("GET")
+
+	// 年度API
+	r.HandleFunc("/api/fiscal/definitions", fiscalHandler.GetFiscalDefinitions).Methods("GET")
+	r.HandleFunc("/api/fiscal/date", fiscalHandler.GetFiscalDate).Methods("GET")
+	r.HandleFunc("/api/fiscal/{year:[0-9]+}", fiscalHandler.GetFiscalYearCalendar).Methods("GET")
+
+	// 日付情報API
+	r.HandleFunc("/api/dates/info", dateInfoHandler.GetDateInfo).Methods("POST")
+
+	// 独自休日API
+	r.HandleFunc("/api/custom-holidays", customHolidayHandler.GetCustomHolidays).Methods("GET")
+	r.HandleFunc("/api/custom-holidays", customHolidayHandler.CreateCustomHoliday).Methods("POST")
+	r.HandleFunc("/api/custom-holidays/{id:[0-9]+}", customHolidayHandler.GetCustomHoliday).Methods("GET")
+	r.HandleFunc("/api/custom-holidays/{id:[0-9]+}", customHolidayHandler.UpdateCustomHoliday).Methods("PUT")
+	r.HandleFunc("/api/custom-holidays/{id:[0-9]+}", customHolidayHandler.DeleteCustomHoliday).Methods("DELETE")
 
 	// イベントAPI
 	r.HandleFunc("/api/events", eventHandler.GetEvents).Methods("GET")
 	r.HandleFunc("/api/events", eventHandler.CreateEvent).Methods("POST")
+	r.HandleFunc("/api/events/search", eventHandler.SearchEvents).Methods("GET")
 	r.HandleFunc("/api/events/{id:[0-9]+}", eventHandler.GetEvent).Methods("GET")
 	r.HandleFunc("/api/events/{id:[0-9]+}", eventHandler.UpdateEvent).Methods("PUT")
 	r.HandleFunc("/api/events/{id:[0-9]+}", eventHandler.DeleteEvent).Methods("DELETE")
@@ -54,6 +134,7 @@ func main() {
 		AllowedOrigins:   []string{"http://localhost:3000"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
 		AllowedHeaders:   []string{"Content-Type", "Authorization"},
+		ExposedHeaders:   []string{"Link"},
 		AllowCredentials: true,
 	})
 
@@ -6,4 +6,5 @@ require (
 	github.com/gorilla/mux v1.8.1
 	github.com/lib/pq v1.10.9
 	github.com/rs/cors v1.10.1
+	golang.org/x/text v0.14.0
 )
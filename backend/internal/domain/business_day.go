@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+// MaxBusinessDaySearch 営業日計算で走査する最大日数（約10年）
+// 休業日に当たる繰り返しの回を前営業日・翌営業日に移す日数の上限でもある
+const MaxBusinessDaySearch = 3660
+
+// BusinessDayOptions 営業日計算のオプション
+type BusinessDayOptions struct {
+	// Weekend 休業とする曜日（空の場合は土曜日・日曜日）
+	Weekend []time.Weekday
+	// Regions 休業とする祝日の国・地域コード（空の場合は日本のみ）
+	Regions []string
+	// IncludeCustom 組織独自の休日も休業とするか
+	IncludeCustom bool
+}
+
+// BusinessDay 営業日の判定結果
+type BusinessDay struct {
+	Date          time.Time `json:"date"`
+	IsBusinessDay bool      `json:"is_business_day"`
+	IsWeekend     bool      `json:"is_weekend"`
+	Holidays      []Holiday `json:"holidays"`
+}
+
+// BusinessDayShift N営業日後（前）の計算結果
+type BusinessDayShift struct {
+	From time.Time `json:"from"`
+	Days int       `json:"days"`
+	Date time.Time `json:"date"`
+}
+
+// BusinessDayCount 期間内の営業日数
+type BusinessDayCount struct {
+	Start        time.Time `json:"start"`
+	End          time.Time `json:"end"`
+	BusinessDays int       `json:"business_days"`
+	CalendarDays int       `json:"calendar_days"`
+}
@@ -3,6 +3,7 @@ package domain
 import "time"
 
 // Event イベントドメインモデル
+// RRule・RDates を持つイベントは繰り返しイベント（シリーズ）で、StartDate〜EndDate が最初の回を表す
 type Event struct {
 	ID          int       `json:"id"`
 	Title       string    `json:"title"`
@@ -10,30 +11,213 @@ type Event struct {
 	StartDate   time.Time `json:"start_date"`
 	EndDate     time.Time `json:"end_date"`
 	AllDay      bool      `json:"all_day"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	TimeZone    string    `json:"time_zone"`
+	// RRule 繰り返しの規則（RFC 5545 の RRULE、例: FREQ=WEEKLY;BYDAY=MO）
+	RRule string `json:"rrule,omitempty"`
+	// ExDates 繰り返しから除く回の開始日時
+	ExDates []time.Time `json:"exdates,omitempty"`
+	// RDates 繰り返しに追加する回の開始日時
+	RDates []time.Time `json:"rdates,omitempty"`
+	// BusinessRule 営業日に基づく繰り返しの指定（RRule を拡張する）
+	BusinessRule *EventBusinessRule `json:"business_rule,omitempty"`
+	// RecurringEventID 「この回のみ」の変更で切り離した回の元のシリーズのID
+	RecurringEventID *int `json:"recurring_event_id,omitempty"`
+	// RecurrenceID 繰り返しの回の本来の開始日時（展開した回と切り離した回に付く）
+	RecurrenceID *time.Time `json:"recurrence_id,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
 }
 
+// EventBusinessRule 営業日に基づく繰り返しの指定（例: 毎月の月末営業日、毎月第3営業日、休日なら翌営業日）
+// 休業日は営業日計算と同じく休業曜日・祝日（・独自休日）で判定する
+type EventBusinessRule struct {
+	// BusinessDay 月の第N営業日（負の場合は月末から数え、-1 は月末営業日）。0 の場合は RRule の日付を使う
+	BusinessDay int `json:"business_day,omitempty"`
+	// Shift 回が休業日に当たる場合の移動方向（previous / next / none、省略時は none）
+	Shift RecurrenceShift `json:"shift,omitempty"`
+	// Weekend 休業とする曜日（0: 日曜日〜6: 土曜日、省略時は土曜日・日曜日）
+	Weekend []time.Weekday `json:"weekend,omitempty"`
+	// Regions 休業とする祝日の国・地域コード（省略時は日本のみ）
+	Regions []string `json:"regions,omitempty"`
+	// IncludeCustom 組織独自の休日も休業とするか
+	IncludeCustom bool `json:"include_custom,omitempty"`
+}
+
+// RecurrenceShift 繰り返しの回が休業日に当たる場合の移動方向
+// 移動するのは回の表示上の日時だけで、回は移動前の本来の日時（RecurrenceID）で識別する
+type RecurrenceShift string
+
+const (
+	RecurrenceShiftPrevious RecurrenceShift = "previous" // 前営業日
+	RecurrenceShiftNext     RecurrenceShift = "next"     // 翌営業日
+	RecurrenceShiftNone     RecurrenceShift = "none"     // 移動しない
+)
+
+// 繰り返しイベントを変更する範囲
+const (
+	EventEditScopeThis      = "this"      // この回のみ
+	EventEditScopeFollowing = "following" // この回以降
+	EventEditScopeAll       = "all"       // すべての回
+)
+
 // CalendarDay カレンダーの1日分のデータ
 type CalendarDay struct {
-	Date      time.Time `json:"date"`
-	Day       int       `json:"day"`
-	Weekday   string    `json:"weekday"`
-	IsHoliday bool      `json:"is_holiday"`
-	Holiday   string    `json:"holiday,omitempty"`
-	Rokuyo    string    `json:"rokuyo"`
-	Events    []Event   `json:"events"`
+	Date         time.Time       `json:"date"`
+	Day          int             `json:"day"`
+	Weekday      string          `json:"weekday"`
+	IsHoliday    bool            `json:"is_holiday"`
+	Holiday      string          `json:"holiday,omitempty"`
+	Holidays     []Holiday       `json:"holidays"`
+	Rokuyo       string          `json:"rokuyo"`
+	Lunar        LunarDate       `json:"lunar"`
+	MoonAge      float64         `json:"moon_age"`
+	MoonPhase    string          `json:"moon_phase"`
+	SeasonalDays []SeasonalDay   `json:"seasonal_days"`
+	Eto          string          `json:"eto"`
+	Senjitsu     []string        `json:"senjitsu"`
+	Events       []CalendarEvent `json:"events"`
+	OutsideMonth bool            `json:"outside_month,omitempty"`
+}
+
+// CalendarEvent カレンダーの1日に表示するイベント
+// 複数日にわたるイベントは期間中の各日に置かれ、Position でその日が期間のどこに当たるかを表す
+type CalendarEvent struct {
+	Event
+	Position string `json:"position"`
+}
+
+// イベント期間中の日の位置
+const (
+	EventPositionSingle = "single" // 1日で終わるイベント
+	EventPositionStart  = "start"  // 開始日
+	EventPositionMiddle = "middle" // 開始日と終了日の間の日
+	EventPositionEnd    = "end"    // 終了日
+)
+
+// CalendarWeek カレンダーの1週間（1行）分のデータ
+type CalendarWeek struct {
+	ISOYear int           `json:"iso_year"`
+	ISOWeek int           `json:"iso_week"`
+	Days    []CalendarDay `json:"days"`
+}
+
+// Rekichu 暦注（選日）
+type Rekichu struct {
+	Date time.Time `json:"date"`
+	Name string    `json:"name"`
+	Eto  string    `json:"eto"`
+}
+
+// SeasonalDay 二十四節気・雑節
+type SeasonalDay struct {
+	Date time.Time `json:"date"`
+	Name string    `json:"name"`
+	Type string    `json:"type"`
 }
 
+// 季節の暦の種類
+const (
+	SeasonalTypeSolarTerm = "solar_term" // 二十四節気
+	SeasonalTypeZassetsu  = "zassetsu"   // 雑節
+)
+
+// LunarDate 旧暦の日付
+type LunarDate struct {
+	Year        int  `json:"year"`
+	Month       int  `json:"month"`
+	Day         int  `json:"day"`
+	IsLeapMonth bool `json:"is_leap_month"`
+}
+
+// CalendarOptions カレンダー取得時のオプション
+type CalendarOptions struct {
+	// Regions 祝日を表示する国・地域コード（空の場合は日本のみ）
+	Regions []string
+	// Grid 前後の月の日付で埋めた7日ごとの週（行）も返すかどうか
+	Grid bool
+	// WeekStart 週の開始曜日（日曜日または月曜日）
+	WeekStart time.Weekday
+	// Location 日付の区切りに使うタイムゾーン（nil の場合は各イベントのタイムゾーン、日付はUTC）
+	Location *time.Location
+	// Language 曜日・祝日名・六曜の表示言語（空の場合は日本語）
+	Language string
+}
+
+// 表示言語
+const (
+	LanguageJapanese = "ja"
+	LanguageEnglish  = "en"
+)
+
+// カレンダー・祝日・暦の計算に対応する年の範囲（両端を含む）
+// 日本でグレゴリオ暦を採用した明治6年から、天文計算の精度を保てる範囲まで
+const (
+	MinSupportedYear = 1873
+	MaxSupportedYear = 2200
+)
+
 // Calendar カレンダー情報
 type Calendar struct {
-	Year  int           `json:"year"`
-	Month int           `json:"month"`
-	Days  []CalendarDay `json:"days"`
+	Year  int            `json:"year"`
+	Month int            `json:"month"`
+	Eras  []EraYear      `json:"eras"`
+	Days  []CalendarDay  `json:"days"`
+	Weeks []CalendarWeek `json:"weeks,omitempty"`
+}
+
+// CalendarYear 1年分（12か月）のカレンダー情報
+type CalendarYear struct {
+	Year   int        `json:"year"`
+	Eras   []EraYear  `json:"eras"`
+	Months []Calendar `json:"months"`
 }
 
 // Holiday 祝日情報
 type Holiday struct {
-	Date time.Time `json:"date"`
-	Name string    `json:"name"`
+	Date   time.Time `json:"date"`
+	Name   string    `json:"name"`
+	Type   string    `json:"type"`
+	Region string    `json:"region,omitempty"`
+	Method string    `json:"method,omitempty"`
+	Source string    `json:"source,omitempty"`
+}
+
+// 祝日の種類
+const (
+	HolidayTypeNational = "national" // 国・地域の祝日
+	HolidayTypeCustom   = "custom"   // 組織独自の休日
+)
+
+// 祝日データの出典
+const (
+	HolidaySourceOfficial = "official" // 内閣府公表の祝日データ（syukujitsu.csv）
+	HolidaySourceComputed = "computed" // 祝日法に基づく計算
+)
+
+// HolidayDiff 計算した祝日と公表データの差異
+type HolidayDiff struct {
+	Date     time.Time `json:"date"`
+	Official string    `json:"official,omitempty"`
+	Computed string    `json:"computed,omitempty"`
+	Type     string    `json:"type"`
 }
+
+// 祝日の差異の種類
+const (
+	HolidayDiffMissingComputed = "missing_computed" // 公表データのみに存在
+	HolidayDiffMissingOfficial = "missing_official" // 計算結果のみに存在
+	HolidayDiffNameMismatch    = "name_mismatch"    // 日付は一致するが名称が異なる
+)
+
+// 祝日の日付の算出方法
+const (
+	HolidayMethodFixed        = "fixed"        // 固定日
+	HolidayMethodHappyMonday  = "happy_monday" // 第N月曜日
+	HolidayMethodNthWeekday   = "nth_weekday"  // 第N〇曜日（日本以外）
+	HolidayMethodLunar        = "lunar"        // 太陰太陽暦の日付
+	HolidayMethodAstronomical = "astronomical" // 太陽黄経の天文計算（春分・秋分・清明など）
+	HolidayMethodSpecial      = "special"      // 特別法・特例による日付
+	HolidayMethodSubstitute   = "substitute"   // 振替休日
+	HolidayMethodCitizens     = "citizens"     // 国民の休日
+	HolidayMethodObserved     = "observed"     // 週末に当たる祝日の振替（日本以外）
+)
@@ -0,0 +1,16 @@
+package domain
+
+import "time"
+
+// CustomHoliday 組織独自の休日・休業期間（創立記念日、年末年始休業など）
+type CustomHoliday struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	StartDate   time.Time `json:"start_date"`
+	EndDate     time.Time `json:"end_date"`
+	// Recurring true の場合は開始日の年以降、毎年同じ月日に繰り返す
+	Recurring bool      `json:"recurring"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
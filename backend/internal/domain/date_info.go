@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+// DateInfo 日付の曜日・六曜・祝日・営業日の情報
+type DateInfo struct {
+	Date          time.Time `json:"date"`
+	Weekday       string    `json:"weekday"`
+	Rokuyo        string    `json:"rokuyo"`
+	IsHoliday     bool      `json:"is_holiday"`
+	Holidays      []Holiday `json:"holidays"`
+	IsWeekend     bool      `json:"is_weekend"`
+	IsBusinessDay bool      `json:"is_business_day"`
+}
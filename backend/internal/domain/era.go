@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+// Era 元号
+type Era struct {
+	Name      string     `json:"name"`
+	Romaji    string     `json:"romaji"`
+	StartDate time.Time  `json:"start_date"`
+	EndDate   *time.Time `json:"end_date,omitempty"`
+}
+
+// EraYear 元号と年（例: 令和7年）
+type EraYear struct {
+	Era   string `json:"era"`
+	Year  int    `json:"year"`
+	Label string `json:"label"`
+}
+
+// JapaneseDate 和暦の日付
+type JapaneseDate struct {
+	Date      time.Time `json:"date"`
+	Era       string    `json:"era"`
+	EraYear   int       `json:"era_year"`
+	Month     int       `json:"month"`
+	Day       int       `json:"day"`
+	Formatted string    `json:"formatted"`
+}
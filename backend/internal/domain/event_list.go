@@ -0,0 +1,35 @@
+package domain
+
+import "time"
+
+// イベント一覧の並び替えの項目
+const (
+	EventSortStartDate = "start_date"
+	EventSortUpdatedAt = "updated_at"
+	EventSortTitle     = "title"
+)
+
+// EventListOptions イベント一覧の並び替え・絞り込み・ページ送りの指定
+type EventListOptions struct {
+	Sort         string     // 並び替えの項目（省略時は start_date）
+	Desc         bool       // 降順
+	Limit        int        // 1ページの件数（0 の場合は既定の件数）
+	Cursor       string     // 前のページの next_cursor（省略時は最初のページ）
+	AllDay       *bool      // 終日イベントかどうかで絞り込む
+	UpdatedSince *time.Time // この日時以降に更新したイベントに絞り込む
+	TitlePrefix  string     // タイトルの前方一致で絞り込む
+}
+
+// EventCursor 一覧のページの最後のイベントの並び替えの値とID（次のページはこの後から始まる）
+type EventCursor struct {
+	Sort  string `json:"s"`
+	Desc  bool   `json:"d,omitempty"`
+	Value string `json:"v"`
+	ID    int    `json:"i"`
+}
+
+// EventPage イベント一覧の1ページ
+type EventPage struct {
+	Events     []Event `json:"events"`
+	NextCursor string  `json:"next_cursor,omitempty"` // 次のページがない場合は空
+}
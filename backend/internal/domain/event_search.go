@@ -0,0 +1,14 @@
+package domain
+
+// EventSearchResult イベント検索の結果
+type EventSearchResult struct {
+	Event      Event           `json:"event"`
+	Score      float64         `json:"score"` // 関連度（大きいほど検索語に近い）
+	Highlights EventHighlights `json:"highlights"`
+}
+
+// EventHighlights 検索語に一致した部分を <mark> で囲んだタイトル・説明（HTMLエスケープ済み）
+type EventHighlights struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"` // 説明が一致した場合のみ、一致した部分の前後を抜き出す
+}
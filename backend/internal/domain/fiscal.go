@@ -0,0 +1,60 @@
+package domain
+
+import "time"
+
+// FiscalTermDefinition 年度内の学期・期の定義（開始月日から次の期の前日まで）
+type FiscalTermDefinition struct {
+	Name       string `json:"name"`
+	StartMonth int    `json:"start_month"`
+	StartDay   int    `json:"start_day"`
+}
+
+// FiscalYearDefinition 年度の定義（例: 4月始まりの会計年度、3学期制の学校年度）
+// 年度は開始日のある年で呼ぶ（2025年4月〜2026年3月は2025年度）
+type FiscalYearDefinition struct {
+	ID         string                 `json:"id"`
+	Name       string                 `json:"name"`
+	StartMonth int                    `json:"start_month"`
+	Terms      []FiscalTermDefinition `json:"terms"`
+}
+
+// FiscalOptions 年度の指定
+type FiscalOptions struct {
+	// Definition 年度の定義のID（空の場合は会計年度）
+	Definition string
+	// StartMonth 年度の開始月（指定した場合は学期・期のない年度として扱う）
+	StartMonth int
+}
+
+// FiscalPeriod 年度内の期間（四半期・学期など）
+type FiscalPeriod struct {
+	Name  string    `json:"name"`
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// FiscalDate 日付の年度・四半期・年度内の月
+type FiscalDate struct {
+	Date        time.Time     `json:"date"`
+	Definition  string        `json:"definition"`
+	FiscalYear  int           `json:"fiscal_year"`
+	Name        string        `json:"name"`
+	Half        int           `json:"half"`
+	Quarter     int           `json:"quarter"`
+	FiscalMonth int           `json:"fiscal_month"`
+	Year        FiscalPeriod  `json:"year"`
+	QuarterSpan FiscalPeriod  `json:"quarter_span"`
+	Term        *FiscalPeriod `json:"term,omitempty"`
+}
+
+// FiscalYearCalendar 年度単位のカレンダー（開始月から12か月分）
+type FiscalYearCalendar struct {
+	Definition string         `json:"definition"`
+	FiscalYear int            `json:"fiscal_year"`
+	Name       string         `json:"name"`
+	Start      time.Time      `json:"start"`
+	End        time.Time      `json:"end"`
+	Quarters   []FiscalPeriod `json:"quarters"`
+	Terms      []FiscalPeriod `json:"terms"`
+	Months     []Calendar     `json:"months"`
+}
@@ -0,0 +1,38 @@
+package domain
+
+import "time"
+
+// 支払日が休業日に当たる場合の移動方向
+const (
+	SettlementShiftPrevious = "previous" // 前営業日
+	SettlementShiftNext     = "next"     // 翌営業日
+	SettlementShiftNone     = "none"     // 移動しない
+)
+
+// SettlementRule 締め日・支払日の規則（例: 月末締め翌月25日払い、休日なら前営業日）
+type SettlementRule struct {
+	// ClosingDay 締め日（0 は月末、月の日数を超える場合も月末）
+	ClosingDay int `json:"closing_day"`
+	// PaymentMonthOffset 締め日の月から支払月までの月数（0: 当月、1: 翌月、2: 翌々月）
+	PaymentMonthOffset int `json:"payment_month_offset"`
+	// PaymentDay 支払日（0 は月末、月の日数を超える場合も月末）
+	PaymentDay int `json:"payment_day"`
+	// Shift 支払日が休業日に当たる場合の移動方向
+	Shift string `json:"shift"`
+}
+
+// SettlementEntry 1回分の締め日と支払日
+type SettlementEntry struct {
+	PeriodStart          time.Time `json:"period_start"`
+	ClosingDate          time.Time `json:"closing_date"`
+	ScheduledPaymentDate time.Time `json:"scheduled_payment_date"`
+	PaymentDate          time.Time `json:"payment_date"`
+	Shifted              bool      `json:"shifted"`
+}
+
+// SettlementSchedule 指定年の締め日・支払日の一覧
+type SettlementSchedule struct {
+	Year    int               `json:"year"`
+	Rule    SettlementRule    `json:"rule"`
+	Entries []SettlementEntry `json:"entries"`
+}
@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// BusinessDayServiceInterface は営業日サービスのインターフェース
+type BusinessDayServiceInterface interface {
+	CheckBusinessDay(date time.Time, opts domain.BusinessDayOptions) (*domain.BusinessDay, error)
+	AddBusinessDays(date time.Time, n int, opts domain.BusinessDayOptions) (*domain.BusinessDayShift, error)
+	NextBusinessDay(date time.Time, opts domain.BusinessDayOptions) (*domain.BusinessDay, error)
+	PreviousBusinessDay(date time.Time, opts domain.BusinessDayOptions) (*domain.BusinessDay, error)
+	CountBusinessDays(start, end time.Time, opts domain.BusinessDayOptions) (*domain.BusinessDayCount, error)
+}
+
+type BusinessDayHandler struct {
+	service BusinessDayServiceInterface
+}
+
+func NewBusinessDayHandler(service BusinessDayServiceInterface) *BusinessDayHandler {
+	return &BusinessDayHandler{service: service}
+}
+
+// weekdayNames weekend パラメータで使える曜日の表記
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+	"日": time.Sunday, "月": time.Monday, "火": time.Tuesday, "水": time.Wednesday,
+	"木": time.Thursday, "金": time.Friday, "土": time.Saturday,
+}
+
+// CheckBusinessDay 営業日判定
+func (h *BusinessDayHandler) CheckBusinessDay(w http.ResponseWriter, r *http.Request) {
+	date, opts, ok := parseBusinessDayRequest(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.service.CheckBusinessDay(date, opts)
+	writeBusinessDayResponse(w, result, err)
+}
+
+// AddBusinessDays N営業日後（days が負の場合はN営業日前）の日付を取得
+func (h *BusinessDayHandler) AddBusinessDays(w http.ResponseWriter, r *http.Request) {
+	date, opts, ok := parseBusinessDayRequest(w, r)
+	if !ok {
+		return
+	}
+
+	days, err := strconv.Atoi(r.URL.Query().Get("days"))
+	if err != nil {
+		http.Error(w, "Invalid days", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.service.AddBusinessDays(date, days, opts)
+	writeBusinessDayResponse(w, result, err)
+}
+
+// NextBusinessDay 翌営業日を取得
+func (h *BusinessDayHandler) NextBusinessDay(w http.ResponseWriter, r *http.Request) {
+	date, opts, ok := parseBusinessDayRequest(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.service.NextBusinessDay(date, opts)
+	writeBusinessDayResponse(w, result, err)
+}
+
+// PreviousBusinessDay 前営業日を取得
+func (h *BusinessDayHandler) PreviousBusinessDay(w http.ResponseWriter, r *http.Request) {
+	date, opts, ok := parseBusinessDayRequest(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.service.PreviousBusinessDay(date, opts)
+	writeBusinessDayResponse(w, result, err)
+}
+
+// CountBusinessDays 期間内（start〜end、両端を含む）の営業日数を取得
+func (h *BusinessDayHandler) CountBusinessDays(w http.ResponseWriter, r *http.Request) {
+	start, err := time.Parse("2006-01-02", r.URL.Query().Get("start"))
+	if err != nil {
+		http.Error(w, "Invalid start", http.StatusBadRequest)
+		return
+	}
+	end, err := time.Parse("2006-01-02", r.URL.Query().Get("end"))
+	if err != nil {
+		http.Error(w, "Invalid end", http.StatusBadRequest)
+		return
+	}
+	opts, err := parseBusinessDayOptions(r)
+	if err != nil {
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.service.CountBusinessDays(start, end, opts)
+	writeBusinessDayResponse(w, result, err)
+}
+
+// parseBusinessDayRequest date パラメータと営業日オプションを解析
+// 不正な場合は 400 を書き込み ok=false を返す
+func parseBusinessDayRequest(w http.ResponseWriter, r *http.Request) (time.Time, domain.BusinessDayOptions, bool) {
+	date, err := time.Parse("2006-01-02", r.URL.Query().Get("date"))
+	if err != nil {
+		http.Error(w, "Invalid date", http.StatusBadRequest)
+		return time.Time{}, domain.BusinessDayOptions{}, false
+	}
+
+	opts, err := parseBusinessDayOptions(r)
+	if err != nil {
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return time.Time{}, domain.BusinessDayOptions{}, false
+	}
+
+	return date, opts, true
+}
+
+// parseBusinessDayOptions weekend・region・custom パラメータを解析
+// weekend は sat,sun / 土,日 / 6,0 のいずれの表記でもカンマ区切りで指定できる
+func parseBusinessDayOptions(r *http.Request) (domain.BusinessDayOptions, error) {
+	query := r.URL.Query()
+	opts := domain.BusinessDayOptions{Regions: parseRegions(r)}
+
+	if value := query.Get("weekend"); value != "" {
+		for _, name := range strings.Split(value, ",") {
+			name = strings.ToLower(strings.TrimSpace(name))
+			if wd, ok := weekdayNames[name]; ok {
+				opts.Weekend = append(opts.Weekend, wd)
+				continue
+			}
+			n, err := strconv.Atoi(name)
+			if err != nil || n < 0 || n > 6 {
+				return opts, domain.ErrInvalidInput
+			}
+			opts.Weekend = append(opts.Weekend, time.Weekday(n))
+		}
+	}
+
+	if value := query.Get("custom"); value != "" {
+		includeCustom, err := strconv.ParseBool(value)
+		if err != nil {
+			return opts, domain.ErrInvalidInput
+		}
+		opts.IncludeCustom = includeCustom
+	}
+
+	return opts, nil
+}
+
+// writeBusinessDayResponse 営業日計算の結果またはエラーを書き込む
+func writeBusinessDayResponse(w http.ResponseWriter, result interface{}, err error) {
+	if err == domain.ErrInvalidInput {
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+	if err == domain.ErrNotFound {
+		http.Error(w, "Business day not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
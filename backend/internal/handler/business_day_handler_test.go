@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// MockBusinessDayService はテスト用のモックサービス
+type MockBusinessDayService struct {
+	CheckBusinessDayFunc    func(date time.Time, opts domain.BusinessDayOptions) (*domain.BusinessDay, error)
+	AddBusinessDaysFunc     func(date time.Time, n int, opts domain.BusinessDayOptions) (*domain.BusinessDayShift, error)
+	NextBusinessDayFunc     func(date time.Time, opts domain.BusinessDayOptions) (*domain.BusinessDay, error)
+	PreviousBusinessDayFunc func(date time.Time, opts domain.BusinessDayOptions) (*domain.BusinessDay, error)
+	CountBusinessDaysFunc   func(start, end time.Time, opts domain.BusinessDayOptions) (*domain.BusinessDayCount, error)
+}
+
+func (m *MockBusinessDayService) CheckBusinessDay(date time.Time, opts domain.BusinessDayOptions) (*domain.BusinessDay, error) {
+	if m.CheckBusinessDayFunc != nil {
+		return m.CheckBusinessDayFunc(date, opts)
+	}
+	return &domain.BusinessDay{Date: date}, nil
+}
+
+func (m *MockBusinessDayService) AddBusinessDays(date time.Time, n int, opts domain.BusinessDayOptions) (*domain.BusinessDayShift, error) {
+	if m.AddBusinessDaysFunc != nil {
+		return m.AddBusinessDaysFunc(date, n, opts)
+	}
+	return &domain.BusinessDayShift{From: date, Days: n, Date: date}, nil
+}
+
+func (m *MockBusinessDayService) NextBusinessDay(date time.Time, opts domain.BusinessDayOptions) (*domain.BusinessDay, error) {
+	if m.NextBusinessDayFunc != nil {
+		return m.NextBusinessDayFunc(date, opts)
+	}
+	return &domain.BusinessDay{Date: date, IsBusinessDay: true}, nil
+}
+
+func (m *MockBusinessDayService) PreviousBusinessDay(date time.Time, opts domain.BusinessDayOptions) (*domain.BusinessDay, error) {
+	if m.PreviousBusinessDayFunc != nil {
+		return m.PreviousBusinessDayFunc(date, opts)
+	}
+	return &domain.BusinessDay{Date: date, IsBusinessDay: true}, nil
+}
+
+func (m *MockBusinessDayService) CountBusinessDays(start, end time.Time, opts domain.BusinessDayOptions) (*domain.BusinessDayCount, error) {
+	if m.CountBusinessDaysFunc != nil {
+		return m.CountBusinessDaysFunc(start, end, opts)
+	}
+	return &domain.BusinessDayCount{Start: start, End: end}, nil
+}
+
+func TestBusinessDayHandler_CheckBusinessDay_Options(t *testing.T) {
+	var got domain.BusinessDayOptions
+	service := &MockBusinessDayService{
+		CheckBusinessDayFunc: func(date time.Time, opts domain.BusinessDayOptions) (*domain.BusinessDay, error) {
+			got = opts
+			return &domain.BusinessDay{Date: date, IsBusinessDay: true, Holidays: []domain.Holiday{}}, nil
+		},
+	}
+	handler := NewBusinessDayHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/business-days/check?date=2025-05-09&weekend=fri,土&region=JP,US&custom=true", nil)
+	w := httptest.NewRecorder()
+
+	handler.CheckBusinessDay(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+	if len(got.Weekend) != 2 || got.Weekend[0] != time.Friday || got.Weekend[1] != time.Saturday {
+		t.Errorf("Expected weekend [Friday Saturday], got %v", got.Weekend)
+	}
+	if len(got.Regions) != 2 || !got.IncludeCustom {
+		t.Errorf("Unexpected options: %+v", got)
+	}
+
+	var result domain.BusinessDay
+	if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if !result.IsBusinessDay {
+		t.Error("Expected is_business_day to be true")
+	}
+}
+
+func TestBusinessDayHandler_InvalidParameters(t *testing.T) {
+	handler := NewBusinessDayHandler(&MockBusinessDayService{})
+
+	tests := []struct {
+		name   string
+		url    string
+		handle func(w http.ResponseWriter, r *http.Request)
+	}{
+		{"missing date", "/api/business-days/check", handler.CheckBusinessDay},
+		{"invalid weekend", "/api/business-days/check?date=2025-05-09&weekend=holiday", handler.CheckBusinessDay},
+		{"invalid custom", "/api/business-days/next?date=2025-05-09&custom=maybe", handler.NextBusinessDay},
+		{"missing days", "/api/business-days/add?date=2025-05-09", handler.AddBusinessDays},
+		{"invalid end", "/api/business-days/count?start=2025-05-01&end=2025/05/31", handler.CountBusinessDays},
+	}
+
+	for _, test := range tests {
+		req := httptest.NewRequest(http.MethodGet, test.url, nil)
+		w := httptest.NewRecorder()
+
+		test.handle(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: Expected status code %d, got %d", test.name, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+func TestBusinessDayHandler_AddBusinessDays(t *testing.T) {
+	service := &MockBusinessDayService{
+		AddBusinessDaysFunc: func(date time.Time, n int, opts domain.BusinessDayOptions) (*domain.BusinessDayShift, error) {
+			if n != 5 {
+				t.Errorf("Expected days 5, got %d", n)
+			}
+			return &domain.BusinessDayShift{From: date, Days: n, Date: time.Date(2025, 5, 8, 0, 0, 0, 0, time.UTC)}, nil
+		},
+	}
+	handler := NewBusinessDayHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/business-days/add?date=2025-04-28&days=5", nil)
+	w := httptest.NewRecorder()
+
+	handler.AddBusinessDays(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var result domain.BusinessDayShift
+	if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if result.Date.Format("2006-01-02") != "2025-05-08" {
+		t.Errorf("Expected 2025-05-08, got %s", result.Date.Format("2006-01-02"))
+	}
+}
+
+func TestBusinessDayHandler_CountBusinessDays_InvalidRange(t *testing.T) {
+	service := &MockBusinessDayService{
+		CountBusinessDaysFunc: func(start, end time.Time, opts domain.BusinessDayOptions) (*domain.BusinessDayCount, error) {
+			return nil, domain.ErrInvalidInput
+		},
+	}
+	handler := NewBusinessDayHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/business-days/count?start=2025-05-31&end=2025-05-01", nil)
+	w := httptest.NewRecorder()
+
+	handler.CountBusinessDays(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status code %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
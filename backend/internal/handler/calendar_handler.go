@@ -4,17 +4,31 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+	"golang.org/x/text/language"
 )
 
 // CalendarServiceInterface はカレンダーサービスのインターフェース
 type CalendarServiceInterface interface {
-	GetCalendar(year, month int) (*domain.Calendar, error)
-	GetHolidays(year int) []domain.Holiday
+	GetCalendar(year, month int, opts domain.CalendarOptions) (*domain.Calendar, error)
+	GetWeekCalendar(year, week int, opts domain.CalendarOptions) (*domain.CalendarWeek, error)
+	GetDayCalendar(year, month, day int, opts domain.CalendarOptions) (*domain.CalendarDay, error)
+	GetYearCalendar(year int, opts domain.CalendarOptions) (*domain.CalendarYear, error)
+	HolidayRegions() []string
+	GetHolidaysForRegions(year int, regions []string) ([]domain.Holiday, error)
+	GetHolidaysBetween(start, end time.Time, regions []string) ([]domain.Holiday, error)
+	GetRekichu(year int) ([]domain.Rekichu, error)
+	DiffOfficialHolidays(year int) ([]domain.HolidayDiff, error)
+	LocalizeHolidays(holidays []domain.Holiday, lang string) []domain.Holiday
 }
 
+// languageMatcher 対応している表示言語（先頭が既定）
+var languageMatcher = language.NewMatcher([]language.Tag{language.Japanese, language.English})
+
 type CalendarHandler struct {
 	service CalendarServiceInterface
 }
@@ -38,16 +52,110 @@ func (h *CalendarHandler) GetCalendar(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	calendar, err := h.service.GetCalendar(year, month)
+	opts, err := parseCalendarOptions(r)
+	if err != nil {
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	calendar, err := h.service.GetCalendar(year, month, opts)
+	if err == domain.ErrInvalidInput {
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
+	setLanguageHeaders(w, opts.Language)
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(calendar)
 }
 
+// GetWeekCalendar 週次カレンダー取得（ISO週番号）
+func (h *CalendarHandler) GetWeekCalendar(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	year, err := strconv.Atoi(vars["year"])
+	if err != nil {
+		http.Error(w, "Invalid year", http.StatusBadRequest)
+		return
+	}
+
+	week, err := strconv.Atoi(vars["week"])
+	if err != nil || week < 1 || week > 53 {
+		http.Error(w, "Invalid week", http.StatusBadRequest)
+		return
+	}
+
+	opts, err := parseCalendarOptions(r)
+	if err != nil {
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	calendarWeek, err := h.service.GetWeekCalendar(year, week, opts)
+	writeCalendarResponse(w, opts.Language, calendarWeek, err)
+}
+
+// GetDayCalendar 日次カレンダー取得
+func (h *CalendarHandler) GetDayCalendar(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	year, err := strconv.Atoi(vars["year"])
+	if err != nil {
+		http.Error(w, "Invalid year", http.StatusBadRequest)
+		return
+	}
+
+	month, err := strconv.Atoi(vars["month"])
+	if err != nil || month < 1 || month > 12 {
+		http.Error(w, "Invalid month", http.StatusBadRequest)
+		return
+	}
+
+	day, err := strconv.Atoi(vars["day"])
+	if err != nil || day < 1 || day > 31 {
+		http.Error(w, "Invalid day", http.StatusBadRequest)
+		return
+	}
+
+	opts, err := parseCalendarOptions(r)
+	if err != nil {
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	calendarDay, err := h.service.GetDayCalendar(year, month, day, opts)
+	writeCalendarResponse(w, opts.Language, calendarDay, err)
+}
+
+// GetYearCalendar 年次カレンダー（12か月分）取得
+func (h *CalendarHandler) GetYearCalendar(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	year, err := strconv.Atoi(vars["year"])
+	if err != nil {
+		http.Error(w, "Invalid year", http.StatusBadRequest)
+		return
+	}
+
+	opts, err := parseCalendarOptions(r)
+	if err != nil {
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	calendarYear, err := h.service.GetYearCalendar(year, opts)
+	writeCalendarResponse(w, opts.Language, calendarYear, err)
+}
+
+// GetHolidayRegions 祝日を取得できる国・地域コードの一覧取得
+func (h *CalendarHandler) GetHolidayRegions(w http.ResponseWriter, r *http.Request) {
+	regions := h.service.HolidayRegions()
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(regions)
+}
+
 // GetHolidays 祝日一覧取得
 func (h *CalendarHandler) GetHolidays(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
@@ -57,8 +165,237 @@ func (h *CalendarHandler) GetHolidays(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	holidays := h.service.GetHolidays(year)
+	lang, err := parseLanguage(r)
+	if err != nil {
+		http.Error(w, "Invalid lang", http.StatusBadRequest)
+		return
+	}
 
+	holidays, err := h.service.GetHolidaysForRegions(year, parseRegions(r))
+	if err == domain.ErrInvalidInput {
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	holidays = h.service.LocalizeHolidays(holidays, lang)
+
+	setLanguageHeaders(w, lang)
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(holidays)
 }
+
+// GetHolidaysInRange 期間内（from〜to、両端を含む）の祝日一覧を取得
+func (h *CalendarHandler) GetHolidaysInRange(w http.ResponseWriter, r *http.Request) {
+	from, err := time.Parse("2006-01-02", r.URL.Query().Get("from"))
+	if err != nil {
+		http.Error(w, "Invalid from", http.StatusBadRequest)
+		return
+	}
+	to, err := time.Parse("2006-01-02", r.URL.Query().Get("to"))
+	if err != nil {
+		http.Error(w, "Invalid to", http.StatusBadRequest)
+		return
+	}
+
+	lang, err := parseLanguage(r)
+	if err != nil {
+		http.Error(w, "Invalid lang", http.StatusBadRequest)
+		return
+	}
+
+	holidays, err := h.service.GetHolidaysBetween(from, to, parseRegions(r))
+	if err == domain.ErrInvalidInput {
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	holidays = h.service.LocalizeHolidays(holidays, lang)
+
+	setLanguageHeaders(w, lang)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(holidays)
+}
+
+// GetHolidayDiff 計算した祝日と内閣府公表データの差異を取得
+func (h *CalendarHandler) GetHolidayDiff(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	year, err := strconv.Atoi(vars["year"])
+	if err != nil {
+		http.Error(w, "Invalid year", http.StatusBadRequest)
+		return
+	}
+
+	diffs, err := h.service.DiffOfficialHolidays(year)
+	if err == domain.ErrInvalidInput {
+		http.Error(w, "Invalid year", http.StatusBadRequest)
+		return
+	}
+	if err == domain.ErrNotFound {
+		http.Error(w, "Official holiday data is not available for the year", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(diffs)
+}
+
+// GetRekichu 選日一覧取得
+func (h *CalendarHandler) GetRekichu(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	year, err := strconv.Atoi(vars["year"])
+	if err != nil {
+		http.Error(w, "Invalid year", http.StatusBadRequest)
+		return
+	}
+
+	rekichu, err := h.service.GetRekichu(year)
+	if err == domain.ErrInvalidInput {
+		http.Error(w, "Invalid year", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(rekichu)
+}
+
+// writeCalendarResponse カレンダー取得の結果またはエラーを書き込む
+func writeCalendarResponse(w http.ResponseWriter, lang string, result interface{}, err error) {
+	if err == domain.ErrInvalidInput {
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	setLanguageHeaders(w, lang)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// parseCalendarOptions region・grid・week_start・tz・lang パラメータを解析
+// week_start は sun / mon（日 / 月、0 / 1）のいずれかで、省略時は日曜始まり
+func parseCalendarOptions(r *http.Request) (domain.CalendarOptions, error) {
+	query := r.URL.Query()
+	opts := domain.CalendarOptions{Regions: parseRegions(r), WeekStart: time.Sunday}
+
+	loc, err := parseTimeZone(r)
+	if err != nil {
+		return opts, err
+	}
+	opts.Location = loc
+
+	lang, err := parseLanguage(r)
+	if err != nil {
+		return opts, err
+	}
+	opts.Language = lang
+
+	if value := query.Get("grid"); value != "" {
+		grid, err := strconv.ParseBool(value)
+		if err != nil {
+			return opts, domain.ErrInvalidInput
+		}
+		opts.Grid = grid
+	}
+
+	if value := strings.ToLower(strings.TrimSpace(query.Get("week_start"))); value != "" {
+		weekStart, ok := weekdayNames[value]
+		if !ok {
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return opts, domain.ErrInvalidInput
+			}
+			weekStart = time.Weekday(n)
+		}
+		if weekStart != time.Sunday && weekStart != time.Monday {
+			return opts, domain.ErrInvalidInput
+		}
+		opts.WeekStart = weekStart
+	}
+
+	return opts, nil
+}
+
+// parseLanguage 表示言語を決める
+// lang パラメータ（ja / en）を優先し、指定がない場合は Accept-Language ヘッダーから対応言語を選ぶ。
+// どちらもない場合や対応言語がない場合は日本語とする
+func parseLanguage(r *http.Request) (string, error) {
+	if value := r.URL.Query().Get("lang"); value != "" {
+		tag, err := language.Parse(value)
+		if err != nil {
+			return "", domain.ErrInvalidInput
+		}
+		base, _ := tag.Base()
+		switch base.String() {
+		case domain.LanguageJapanese, domain.LanguageEnglish:
+			return base.String(), nil
+		}
+		return "", domain.ErrInvalidInput
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(r.Header.Get("Accept-Language"))
+	if err != nil || len(tags) == 0 {
+		return domain.LanguageJapanese, nil
+	}
+	_, index, confidence := languageMatcher.Match(tags...)
+	if confidence == language.No || index == 0 {
+		return domain.LanguageJapanese, nil
+	}
+	return domain.LanguageEnglish, nil
+}
+
+// setLanguageHeaders 表示言語のレスポンスヘッダーを設定
+func setLanguageHeaders(w http.ResponseWriter, lang string) {
+	if lang == "" {
+		lang = domain.LanguageJapanese
+	}
+	w.Header().Set("Content-Language", lang)
+	w.Header().Add("Vary", "Accept-Language")
+}
+
+// parseTimeZone tz パラメータ（IANAタイムゾーン名）を解析
+// 指定がない場合は nil を返す
+func parseTimeZone(r *http.Request) (*time.Location, error) {
+	value := r.URL.Query().Get("tz")
+	if value == "" {
+		return nil, nil
+	}
+	if value == "Local" {
+		return nil, domain.ErrInvalidInput
+	}
+	loc, err := time.LoadLocation(value)
+	if err != nil {
+		return nil, domain.ErrInvalidInput
+	}
+	return loc, nil
+}
+
+// parseRegions region クエリパラメータを国・地域コードの一覧に変換
+// ?region=JP,US のカンマ区切りと ?region=JP&region=US の繰り返し指定の両方に対応する
+func parseRegions(r *http.Request) []string {
+	regions := []string{}
+	for _, value := range r.URL.Query()["region"] {
+		for _, region := range strings.Split(value, ",") {
+			if region = strings.TrimSpace(region); region != "" {
+				regions = append(regions, region)
+			}
+		}
+	}
+	return regions
+}
@@ -14,22 +14,86 @@ import (
 
 // MockCalendarService はテスト用のモックサービス
 type MockCalendarService struct {
-	GetCalendarFunc func(year, month int) (*domain.Calendar, error)
-	GetHolidaysFunc func(year int) []domain.Holiday
+	GetCalendarFunc           func(year, month int, opts domain.CalendarOptions) (*domain.Calendar, error)
+	GetWeekCalendarFunc       func(year, week int, opts domain.CalendarOptions) (*domain.CalendarWeek, error)
+	GetDayCalendarFunc        func(year, month, day int, opts domain.CalendarOptions) (*domain.CalendarDay, error)
+	GetYearCalendarFunc       func(year int, opts domain.CalendarOptions) (*domain.CalendarYear, error)
+	HolidayRegionsFunc        func() []string
+	GetHolidaysForRegionsFunc func(year int, regions []string) ([]domain.Holiday, error)
+	GetHolidaysBetweenFunc    func(start, end time.Time, regions []string) ([]domain.Holiday, error)
+	GetRekichuFunc            func(year int) ([]domain.Rekichu, error)
+	DiffOfficialHolidaysFunc  func(year int) ([]domain.HolidayDiff, error)
+	LocalizeHolidaysFunc      func(holidays []domain.Holiday, lang string) []domain.Holiday
 }
 
-func (m *MockCalendarService) GetCalendar(year, month int) (*domain.Calendar, error) {
+func (m *MockCalendarService) GetCalendar(year, month int, opts domain.CalendarOptions) (*domain.Calendar, error) {
 	if m.GetCalendarFunc != nil {
-		return m.GetCalendarFunc(year, month)
+		return m.GetCalendarFunc(year, month, opts)
 	}
 	return nil, nil
 }
 
-func (m *MockCalendarService) GetHolidays(year int) []domain.Holiday {
-	if m.GetHolidaysFunc != nil {
-		return m.GetHolidaysFunc(year)
+func (m *MockCalendarService) GetWeekCalendar(year, week int, opts domain.CalendarOptions) (*domain.CalendarWeek, error) {
+	if m.GetWeekCalendarFunc != nil {
+		return m.GetWeekCalendarFunc(year, week, opts)
 	}
-	return []domain.Holiday{}
+	return &domain.CalendarWeek{ISOYear: year, ISOWeek: week, Days: []domain.CalendarDay{}}, nil
+}
+
+func (m *MockCalendarService) GetDayCalendar(year, month, day int, opts domain.CalendarOptions) (*domain.CalendarDay, error) {
+	if m.GetDayCalendarFunc != nil {
+		return m.GetDayCalendarFunc(year, month, day, opts)
+	}
+	return &domain.CalendarDay{Date: time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC), Day: day}, nil
+}
+
+func (m *MockCalendarService) GetYearCalendar(year int, opts domain.CalendarOptions) (*domain.CalendarYear, error) {
+	if m.GetYearCalendarFunc != nil {
+		return m.GetYearCalendarFunc(year, opts)
+	}
+	return &domain.CalendarYear{Year: year, Months: []domain.Calendar{}}, nil
+}
+
+func (m *MockCalendarService) HolidayRegions() []string {
+	if m.HolidayRegionsFunc != nil {
+		return m.HolidayRegionsFunc()
+	}
+	return []string{"JP"}
+}
+
+func (m *MockCalendarService) GetHolidaysForRegions(year int, regions []string) ([]domain.Holiday, error) {
+	if m.GetHolidaysForRegionsFunc != nil {
+		return m.GetHolidaysForRegionsFunc(year, regions)
+	}
+	return []domain.Holiday{}, nil
+}
+
+func (m *MockCalendarService) GetHolidaysBetween(start, end time.Time, regions []string) ([]domain.Holiday, error) {
+	if m.GetHolidaysBetweenFunc != nil {
+		return m.GetHolidaysBetweenFunc(start, end, regions)
+	}
+	return []domain.Holiday{}, nil
+}
+
+func (m *MockCalendarService) GetRekichu(year int) ([]domain.Rekichu, error) {
+	if m.GetRekichuFunc != nil {
+		return m.GetRekichuFunc(year)
+	}
+	return []domain.Rekichu{}, nil
+}
+
+func (m *MockCalendarService) DiffOfficialHolidays(year int) ([]domain.HolidayDiff, error) {
+	if m.DiffOfficialHolidaysFunc != nil {
+		return m.DiffOfficialHolidaysFunc(year)
+	}
+	return []domain.HolidayDiff{}, nil
+}
+
+func (m *MockCalendarService) LocalizeHolidays(holidays []domain.Holiday, lang string) []domain.Holiday {
+	if m.LocalizeHolidaysFunc != nil {
+		return m.LocalizeHolidaysFunc(holidays, lang)
+	}
+	return holidays
 }
 
 func TestNewCalendarHandler(t *testing.T) {
@@ -58,7 +122,7 @@ func TestCalendarHandler_GetCalendar_Success(t *testing.T) {
 	}
 
 	service := &MockCalendarService{
-		GetCalendarFunc: func(year, month int) (*domain.Calendar, error) {
+		GetCalendarFunc: func(year, month int, opts domain.CalendarOptions) (*domain.Calendar, error) {
 			if year == 2025 && month == 12 {
 				return mockCalendar, nil
 			}
@@ -155,6 +219,33 @@ func TestCalendarHandler_GetCalendar_MonthOutOfRange(t *testing.T) {
 	}
 }
 
+func TestCalendarHandler_GetHolidayRegions(t *testing.T) {
+	service := &MockCalendarService{
+		HolidayRegionsFunc: func() []string {
+			return []string{"JP", "TW", "US"}
+		},
+	}
+
+	handler := NewCalendarHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/holidays/regions", nil)
+	w := httptest.NewRecorder()
+
+	handler.GetHolidayRegions(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var regions []string
+	if err := json.NewDecoder(w.Body).Decode(&regions); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(regions) != 3 || regions[0] != "JP" || regions[2] != "US" {
+		t.Errorf("Expected [JP TW US], got %v", regions)
+	}
+}
+
 func TestCalendarHandler_GetHolidays_Success(t *testing.T) {
 	mockHolidays := []domain.Holiday{
 		{
@@ -168,11 +259,11 @@ func TestCalendarHandler_GetHolidays_Success(t *testing.T) {
 	}
 
 	service := &MockCalendarService{
-		GetHolidaysFunc: func(year int) []domain.Holiday {
+		GetHolidaysForRegionsFunc: func(year int, regions []string) ([]domain.Holiday, error) {
 			if year == 2025 {
-				return mockHolidays
+				return mockHolidays, nil
 			}
-			return []domain.Holiday{}
+			return []domain.Holiday{}, nil
 		},
 	}
 
@@ -202,6 +293,92 @@ func TestCalendarHandler_GetHolidays_Success(t *testing.T) {
 	}
 }
 
+func TestCalendarHandler_GetHolidays_Regions(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected []string
+	}{
+		{"", []string{}},
+		{"?region=US", []string{"US"}},
+		{"?region=JP,US", []string{"JP", "US"}},
+		{"?region=JP&region=tw", []string{"JP", "tw"}},
+		{"?region=JP,%20US,", []string{"JP", "US"}},
+	}
+
+	for _, test := range tests {
+		var got []string
+		service := &MockCalendarService{
+			GetHolidaysForRegionsFunc: func(year int, regions []string) ([]domain.Holiday, error) {
+				got = regions
+				return []domain.Holiday{}, nil
+			},
+		}
+		handler := NewCalendarHandler(service)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/holidays/2025"+test.query, nil)
+		req = mux.SetURLVars(req, map[string]string{"year": "2025"})
+		w := httptest.NewRecorder()
+
+		handler.GetHolidays(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("%q: Expected status code %d, got %d", test.query, http.StatusOK, w.Code)
+			continue
+		}
+		if len(got) != len(test.expected) {
+			t.Errorf("%q: Expected regions %v, got %v", test.query, test.expected, got)
+			continue
+		}
+		for i := range got {
+			if got[i] != test.expected[i] {
+				t.Errorf("%q: Expected regions %v, got %v", test.query, test.expected, got)
+				break
+			}
+		}
+	}
+}
+
+func TestCalendarHandler_GetHolidays_InvalidRegion(t *testing.T) {
+	service := &MockCalendarService{
+		GetHolidaysForRegionsFunc: func(year int, regions []string) ([]domain.Holiday, error) {
+			return nil, domain.ErrInvalidInput
+		},
+	}
+	handler := NewCalendarHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/holidays/2025?region=XX", nil)
+	req = mux.SetURLVars(req, map[string]string{"year": "2025"})
+	w := httptest.NewRecorder()
+
+	handler.GetHolidays(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status code %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestCalendarHandler_GetCalendar_InvalidRegion(t *testing.T) {
+	service := &MockCalendarService{
+		GetCalendarFunc: func(year, month int, opts domain.CalendarOptions) (*domain.Calendar, error) {
+			if len(opts.Regions) != 1 || opts.Regions[0] != "XX" {
+				t.Errorf("Expected regions [XX], got %v", opts.Regions)
+			}
+			return nil, domain.ErrInvalidInput
+		},
+	}
+	handler := NewCalendarHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/calendar/2025/12?region=XX", nil)
+	req = mux.SetURLVars(req, map[string]string{"year": "2025", "month": "12"})
+	w := httptest.NewRecorder()
+
+	handler.GetCalendar(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status code %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
 func TestCalendarHandler_GetHolidays_InvalidYear(t *testing.T) {
 	service := &MockCalendarService{}
 	handler := NewCalendarHandler(service)
@@ -231,3 +408,493 @@ func TestCalendarHandler_GetHolidays_EmptyYear(t *testing.T) {
 		t.Errorf("Expected status code %d, got %d", http.StatusBadRequest, w.Code)
 	}
 }
+
+func TestCalendarHandler_GetHolidaysInRange(t *testing.T) {
+	var gotStart, gotEnd time.Time
+	var gotRegions []string
+	service := &MockCalendarService{
+		GetHolidaysBetweenFunc: func(start, end time.Time, regions []string) ([]domain.Holiday, error) {
+			gotStart, gotEnd, gotRegions = start, end, regions
+			return []domain.Holiday{
+				{Date: time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC), Name: "年末休暇", Type: domain.HolidayTypeCustom},
+				{Date: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), Name: "元日", Type: domain.HolidayTypeNational},
+			}, nil
+		},
+	}
+	handler := NewCalendarHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/holidays?from=2025-12-01&to=2026-01-31&region=JP,US&lang=en", nil)
+	w := httptest.NewRecorder()
+
+	handler.GetHolidaysInRange(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+	if !gotStart.Equal(time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)) || !gotEnd.Equal(time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected range 2025-12-01..2026-01-31, got %v..%v", gotStart, gotEnd)
+	}
+	if len(gotRegions) != 2 || gotRegions[0] != "JP" || gotRegions[1] != "US" {
+		t.Errorf("Expected regions [JP US], got %v", gotRegions)
+	}
+	if w.Header().Get("Content-Language") != "en" {
+		t.Errorf("Expected Content-Language en, got %q", w.Header().Get("Content-Language"))
+	}
+
+	var holidays []domain.Holiday
+	if err := json.NewDecoder(w.Body).Decode(&holidays); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(holidays) != 2 {
+		t.Fatalf("Expected 2 holidays, got %d", len(holidays))
+	}
+}
+
+func TestCalendarHandler_GetHolidaysInRange_InvalidParams(t *testing.T) {
+	service := &MockCalendarService{
+		GetHolidaysBetweenFunc: func(start, end time.Time, regions []string) ([]domain.Holiday, error) {
+			return nil, domain.ErrInvalidInput
+		},
+	}
+	handler := NewCalendarHandler(service)
+
+	queries := []string{
+		"",
+		"?from=2025-01-01",
+		"?from=2025-13-01&to=2025-12-31",
+		"?from=2025-01-01&to=2025/12/31",
+		"?from=2025-12-31&to=2025-01-01",
+		"?from=2025-01-01&to=2025-12-31&lang=xx",
+	}
+	for _, query := range queries {
+		req := httptest.NewRequest(http.MethodGet, "/api/holidays"+query, nil)
+		w := httptest.NewRecorder()
+
+		handler.GetHolidaysInRange(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%q: Expected status code %d, got %d", query, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+func TestCalendarHandler_GetRekichu_Success(t *testing.T) {
+	service := &MockCalendarService{
+		GetRekichuFunc: func(year int) ([]domain.Rekichu, error) {
+			return []domain.Rekichu{
+				{Date: time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC), Name: "天赦日", Eto: "甲子"},
+			}, nil
+		},
+	}
+
+	handler := NewCalendarHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/rekichu/2024", nil)
+	req = mux.SetURLVars(req, map[string]string{"year": "2024"})
+	w := httptest.NewRecorder()
+
+	handler.GetRekichu(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var rekichu []domain.Rekichu
+	if err := json.NewDecoder(w.Body).Decode(&rekichu); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	if len(rekichu) != 1 || rekichu[0].Name != "天赦日" {
+		t.Errorf("Unexpected rekichu: %v", rekichu)
+	}
+}
+
+func TestCalendarHandler_GetRekichu_InvalidYear(t *testing.T) {
+	handler := NewCalendarHandler(&MockCalendarService{})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/rekichu/invalid", nil)
+	req = mux.SetURLVars(req, map[string]string{"year": "invalid"})
+	w := httptest.NewRecorder()
+
+	handler.GetRekichu(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status code %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestCalendarHandler_GetRekichu_UnsupportedYear(t *testing.T) {
+	service := &MockCalendarService{
+		GetRekichuFunc: func(year int) ([]domain.Rekichu, error) {
+			return nil, domain.ErrInvalidInput
+		},
+	}
+	handler := NewCalendarHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/rekichu/3000", nil)
+	req = mux.SetURLVars(req, map[string]string{"year": "3000"})
+	w := httptest.NewRecorder()
+
+	handler.GetRekichu(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status code %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestCalendarHandler_GetHolidayDiff_Success(t *testing.T) {
+	service := &MockCalendarService{
+		DiffOfficialHolidaysFunc: func(year int) ([]domain.HolidayDiff, error) {
+			return []domain.HolidayDiff{
+				{
+					Date:     time.Date(2019, 10, 22, 0, 0, 0, 0, time.UTC),
+					Official: "休日（祝日扱い）",
+					Type:     domain.HolidayDiffMissingComputed,
+				},
+			}, nil
+		},
+	}
+	handler := NewCalendarHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/holidays/2019/diff", nil)
+	req = mux.SetURLVars(req, map[string]string{"year": "2019"})
+	w := httptest.NewRecorder()
+
+	handler.GetHolidayDiff(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var diffs []domain.HolidayDiff
+	if err := json.NewDecoder(w.Body).Decode(&diffs); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(diffs) != 1 || diffs[0].Type != domain.HolidayDiffMissingComputed {
+		t.Errorf("Unexpected diffs: %v", diffs)
+	}
+}
+
+func TestCalendarHandler_GetHolidayDiff_NotFound(t *testing.T) {
+	service := &MockCalendarService{
+		DiffOfficialHolidaysFunc: func(year int) ([]domain.HolidayDiff, error) {
+			return nil, domain.ErrNotFound
+		},
+	}
+	handler := NewCalendarHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/holidays/1900/diff", nil)
+	req = mux.SetURLVars(req, map[string]string{"year": "1900"})
+	w := httptest.NewRecorder()
+
+	handler.GetHolidayDiff(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status code %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestCalendarHandler_GetCalendar_GridOptions(t *testing.T) {
+	tests := []struct {
+		url       string
+		grid      bool
+		weekStart time.Weekday
+	}{
+		{"/api/calendar/2025/12", false, time.Sunday},
+		{"/api/calendar/2025/12?grid=true", true, time.Sunday},
+		{"/api/calendar/2025/12?grid=true&week_start=mon", true, time.Monday},
+		{"/api/calendar/2025/12?grid=1&week_start=月", true, time.Monday},
+		{"/api/calendar/2025/12?grid=true&week_start=0", true, time.Sunday},
+	}
+
+	for _, test := range tests {
+		var got domain.CalendarOptions
+		service := &MockCalendarService{
+			GetCalendarFunc: func(year, month int, opts domain.CalendarOptions) (*domain.Calendar, error) {
+				got = opts
+				return &domain.Calendar{Year: year, Month: month}, nil
+			},
+		}
+		handler := NewCalendarHandler(service)
+
+		req := httptest.NewRequest(http.MethodGet, test.url, nil)
+		req = mux.SetURLVars(req, map[string]string{"year": "2025", "month": "12"})
+		w := httptest.NewRecorder()
+
+		handler.GetCalendar(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: Expected status code %d, got %d", test.url, http.StatusOK, w.Code)
+		}
+		if got.Grid != test.grid || got.WeekStart != test.weekStart {
+			t.Errorf("%s: Expected grid %v week start %s, got %v %s", test.url, test.grid, test.weekStart, got.Grid, got.WeekStart)
+		}
+	}
+}
+
+func TestCalendarHandler_GetCalendar_InvalidGridOptions(t *testing.T) {
+	handler := NewCalendarHandler(&MockCalendarService{})
+
+	for _, query := range []string{"grid=maybe", "grid=true&week_start=wed", "grid=true&week_start=7"} {
+		req := httptest.NewRequest(http.MethodGet, "/api/calendar/2025/12?"+query, nil)
+		req = mux.SetURLVars(req, map[string]string{"year": "2025", "month": "12"})
+		w := httptest.NewRecorder()
+
+		handler.GetCalendar(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: Expected status code %d, got %d", query, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+func TestCalendarHandler_GetWeekCalendar(t *testing.T) {
+	var gotWeek int
+	var gotOpts domain.CalendarOptions
+	service := &MockCalendarService{
+		GetWeekCalendarFunc: func(year, week int, opts domain.CalendarOptions) (*domain.CalendarWeek, error) {
+			gotWeek, gotOpts = week, opts
+			return &domain.CalendarWeek{ISOYear: year, ISOWeek: week, Days: []domain.CalendarDay{}}, nil
+		},
+	}
+	handler := NewCalendarHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/calendar/2025/week/19?week_start=mon&region=JP,US", nil)
+	req = mux.SetURLVars(req, map[string]string{"year": "2025", "week": "19"})
+	w := httptest.NewRecorder()
+
+	handler.GetWeekCalendar(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+	if gotWeek != 19 || gotOpts.WeekStart != time.Monday || len(gotOpts.Regions) != 2 {
+		t.Errorf("Unexpected arguments: week %d, opts %+v", gotWeek, gotOpts)
+	}
+
+	var result domain.CalendarWeek
+	if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if result.ISOYear != 2025 || result.ISOWeek != 19 {
+		t.Errorf("Expected 2025-W19, got %d-W%d", result.ISOYear, result.ISOWeek)
+	}
+}
+
+func TestCalendarHandler_GetWeekCalendar_InvalidWeek(t *testing.T) {
+	service := &MockCalendarService{
+		GetWeekCalendarFunc: func(year, week int, opts domain.CalendarOptions) (*domain.CalendarWeek, error) {
+			return nil, domain.ErrInvalidInput
+		},
+	}
+	handler := NewCalendarHandler(service)
+
+	for _, week := range []string{"0", "54", "abc", "53"} {
+		req := httptest.NewRequest(http.MethodGet, "/api/calendar/2025/week/"+week, nil)
+		req = mux.SetURLVars(req, map[string]string{"year": "2025", "week": week})
+		w := httptest.NewRecorder()
+
+		handler.GetWeekCalendar(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("week %s: Expected status code %d, got %d", week, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+func TestCalendarHandler_GetDayCalendar(t *testing.T) {
+	service := &MockCalendarService{
+		GetDayCalendarFunc: func(year, month, day int, opts domain.CalendarOptions) (*domain.CalendarDay, error) {
+			if month == 2 && day == 30 {
+				return nil, domain.ErrInvalidInput
+			}
+			return &domain.CalendarDay{
+				Date:    time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC),
+				Day:     day,
+				Holiday: "こどもの日",
+			}, nil
+		},
+	}
+	handler := NewCalendarHandler(service)
+
+	tests := []struct {
+		month, day string
+		expected   int
+	}{
+		{"5", "5", http.StatusOK},
+		{"2", "30", http.StatusBadRequest},
+		{"13", "1", http.StatusBadRequest},
+		{"5", "32", http.StatusBadRequest},
+	}
+
+	for _, test := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/api/calendar/2025/"+test.month+"/"+test.day, nil)
+		req = mux.SetURLVars(req, map[string]string{"year": "2025", "month": test.month, "day": test.day})
+		w := httptest.NewRecorder()
+
+		handler.GetDayCalendar(w, req)
+
+		if w.Code != test.expected {
+			t.Errorf("%s/%s: Expected status code %d, got %d", test.month, test.day, test.expected, w.Code)
+		}
+	}
+}
+
+func TestCalendarHandler_GetYearCalendar(t *testing.T) {
+	service := &MockCalendarService{
+		GetYearCalendarFunc: func(year int, opts domain.CalendarOptions) (*domain.CalendarYear, error) {
+			months := make([]domain.Calendar, 12)
+			for i := range months {
+				months[i] = domain.Calendar{Year: year, Month: i + 1}
+			}
+			return &domain.CalendarYear{Year: year, Months: months}, nil
+		},
+	}
+	handler := NewCalendarHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/calendar/2025", nil)
+	req = mux.SetURLVars(req, map[string]string{"year": "2025"})
+	w := httptest.NewRecorder()
+
+	handler.GetYearCalendar(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var result domain.CalendarYear
+	if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if result.Year != 2025 || len(result.Months) != 12 {
+		t.Errorf("Expected 12 months of 2025, got %d months of %d", len(result.Months), result.Year)
+	}
+}
+
+func TestCalendarHandler_GetCalendar_TimeZone(t *testing.T) {
+	var got domain.CalendarOptions
+	service := &MockCalendarService{
+		GetCalendarFunc: func(year, month int, opts domain.CalendarOptions) (*domain.Calendar, error) {
+			got = opts
+			return &domain.Calendar{Year: year, Month: month}, nil
+		},
+	}
+	handler := NewCalendarHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/calendar/2025/12?tz=America/New_York", nil)
+	req = mux.SetURLVars(req, map[string]string{"year": "2025", "month": "12"})
+	w := httptest.NewRecorder()
+
+	handler.GetCalendar(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+	if got.Location == nil || got.Location.String() != "America/New_York" {
+		t.Errorf("Expected location America/New_York, got %v", got.Location)
+	}
+
+	for _, tz := range []string{"Invalid/Zone", "Local"} {
+		req := httptest.NewRequest(http.MethodGet, "/api/calendar/2025/12?tz="+tz, nil)
+		req = mux.SetURLVars(req, map[string]string{"year": "2025", "month": "12"})
+		w := httptest.NewRecorder()
+
+		handler.GetCalendar(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: Expected status code %d, got %d", tz, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+func TestCalendarHandler_GetCalendar_Language(t *testing.T) {
+	tests := []struct {
+		url            string
+		acceptLanguage string
+		expected       string
+	}{
+		{"/api/calendar/2025/12", "", "ja"},
+		{"/api/calendar/2025/12", "en-US,en;q=0.9", "en"},
+		{"/api/calendar/2025/12", "fr-FR, en;q=0.5, ja;q=0.8", "ja"},
+		{"/api/calendar/2025/12", "fr-FR", "ja"},
+		{"/api/calendar/2025/12?lang=en", "ja", "en"},
+		{"/api/calendar/2025/12?lang=ja-JP", "en", "ja"},
+	}
+
+	for _, test := range tests {
+		var got string
+		service := &MockCalendarService{
+			GetCalendarFunc: func(year, month int, opts domain.CalendarOptions) (*domain.Calendar, error) {
+				got = opts.Language
+				return &domain.Calendar{Year: year, Month: month}, nil
+			},
+		}
+		handler := NewCalendarHandler(service)
+
+		req := httptest.NewRequest(http.MethodGet, test.url, nil)
+		req = mux.SetURLVars(req, map[string]string{"year": "2025", "month": "12"})
+		if test.acceptLanguage != "" {
+			req.Header.Set("Accept-Language", test.acceptLanguage)
+		}
+		w := httptest.NewRecorder()
+
+		handler.GetCalendar(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s (%s): Expected status code %d, got %d", test.url, test.acceptLanguage, http.StatusOK, w.Code)
+		}
+		if got != test.expected {
+			t.Errorf("%s (%s): Expected language %s, got %s", test.url, test.acceptLanguage, test.expected, got)
+		}
+		if w.Header().Get("Content-Language") != test.expected {
+			t.Errorf("%s (%s): Expected Content-Language %s, got %s", test.url, test.acceptLanguage, test.expected, w.Header().Get("Content-Language"))
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/calendar/2025/12?lang=fr", nil)
+	req = mux.SetURLVars(req, map[string]string{"year": "2025", "month": "12"})
+	w := httptest.NewRecorder()
+
+	NewCalendarHandler(&MockCalendarService{}).GetCalendar(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status code %d for unsupported lang, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestCalendarHandler_GetHolidays_Language(t *testing.T) {
+	var got string
+	service := &MockCalendarService{
+		GetHolidaysForRegionsFunc: func(year int, regions []string) ([]domain.Holiday, error) {
+			return []domain.Holiday{{Date: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Name: "元日"}}, nil
+		},
+		LocalizeHolidaysFunc: func(holidays []domain.Holiday, lang string) []domain.Holiday {
+			got = lang
+			holidays[0].Name = "New Year's Day"
+			return holidays
+		},
+	}
+	handler := NewCalendarHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/holidays/2025", nil)
+	req = mux.SetURLVars(req, map[string]string{"year": "2025"})
+	req.Header.Set("Accept-Language", "en")
+	w := httptest.NewRecorder()
+
+	handler.GetHolidays(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+	if got != "en" {
+		t.Errorf("Expected language en, got %s", got)
+	}
+
+	var holidays []domain.Holiday
+	if err := json.NewDecoder(w.Body).Decode(&holidays); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if holidays[0].Name != "New Year's Day" {
+		t.Errorf("Expected New Year's Day, got %s", holidays[0].Name)
+	}
+}
@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// CustomHolidayServiceInterface は独自休日サービスのインターフェース
+type CustomHolidayServiceInterface interface {
+	GetAllCustomHolidays() ([]domain.CustomHoliday, error)
+	GetCustomHolidayByID(id int) (*domain.CustomHoliday, error)
+	CreateCustomHoliday(holiday *domain.CustomHoliday) error
+	UpdateCustomHoliday(holiday *domain.CustomHoliday) error
+	DeleteCustomHoliday(id int) error
+}
+
+type CustomHolidayHandler struct {
+	service CustomHolidayServiceInterface
+}
+
+func NewCustomHolidayHandler(service CustomHolidayServiceInterface) *CustomHolidayHandler {
+	return &CustomHolidayHandler{service: service}
+}
+
+// GetCustomHolidays 全独自休日取得
+func (h *CustomHolidayHandler) GetCustomHolidays(w http.ResponseWriter, r *http.Request) {
+	holidays, err := h.service.GetAllCustomHolidays()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(holidays)
+}
+
+// GetCustomHoliday 単一独自休日取得
+func (h *CustomHolidayHandler) GetCustomHoliday(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid ID", http.StatusBadRequest)
+		return
+	}
+
+	holiday, err := h.service.GetCustomHolidayByID(id)
+	if err == domain.ErrNotFound {
+		http.Error(w, "Custom holiday not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if holiday == nil {
+		http.Error(w, "Custom holiday not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(holiday)
+}
+
+// CreateCustomHoliday 独自休日作成
+func (h *CustomHolidayHandler) CreateCustomHoliday(w http.ResponseWriter, r *http.Request) {
+	var holiday domain.CustomHoliday
+	if err := json.NewDecoder(r.Body).Decode(&holiday); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.CreateCustomHoliday(&holiday); err != nil {
+		if err == domain.ErrInvalidInput {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(holiday)
+}
+
+// UpdateCustomHoliday 独自休日更新
+func (h *CustomHolidayHandler) UpdateCustomHoliday(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid ID", http.StatusBadRequest)
+		return
+	}
+
+	var holiday domain.CustomHoliday
+	if err := json.NewDecoder(r.Body).Decode(&holiday); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	holiday.ID = id
+
+	if err := h.service.UpdateCustomHoliday(&holiday); err != nil {
+		if err == domain.ErrNotFound {
+			http.Error(w, "Custom holiday not found", http.StatusNotFound)
+			return
+		}
+		if err == domain.ErrInvalidInput {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(holiday)
+}
+
+// DeleteCustomHoliday 独自休日削除
+func (h *CustomHolidayHandler) DeleteCustomHoliday(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		http.Error(w, "Invalid ID", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.DeleteCustomHoliday(id); err != nil {
+		if err == domain.ErrNotFound {
+			http.Error(w, "Custom holiday not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
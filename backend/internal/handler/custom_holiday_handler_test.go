@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// MockCustomHolidayService はテスト用のモックサービス
+type MockCustomHolidayService struct {
+	GetAllCustomHolidaysFunc func() ([]domain.CustomHoliday, error)
+	GetCustomHolidayByIDFunc func(id int) (*domain.CustomHoliday, error)
+	CreateCustomHolidayFunc  func(holiday *domain.CustomHoliday) error
+	UpdateCustomHolidayFunc  func(holiday *domain.CustomHoliday) error
+	DeleteCustomHolidayFunc  func(id int) error
+}
+
+func (m *MockCustomHolidayService) GetAllCustomHolidays() ([]domain.CustomHoliday, error) {
+	if m.GetAllCustomHolidaysFunc != nil {
+		return m.GetAllCustomHolidaysFunc()
+	}
+	return []domain.CustomHoliday{}, nil
+}
+
+func (m *MockCustomHolidayService) GetCustomHolidayByID(id int) (*domain.CustomHoliday, error) {
+	if m.GetCustomHolidayByIDFunc != nil {
+		return m.GetCustomHolidayByIDFunc(id)
+	}
+	return nil, nil
+}
+
+func (m *MockCustomHolidayService) CreateCustomHoliday(holiday *domain.CustomHoliday) error {
+	if m.CreateCustomHolidayFunc != nil {
+		return m.CreateCustomHolidayFunc(holiday)
+	}
+	return nil
+}
+
+func (m *MockCustomHolidayService) UpdateCustomHoliday(holiday *domain.CustomHoliday) error {
+	if m.UpdateCustomHolidayFunc != nil {
+		return m.UpdateCustomHolidayFunc(holiday)
+	}
+	return nil
+}
+
+func (m *MockCustomHolidayService) DeleteCustomHoliday(id int) error {
+	if m.DeleteCustomHolidayFunc != nil {
+		return m.DeleteCustomHolidayFunc(id)
+	}
+	return nil
+}
+
+func TestCustomHolidayHandler_GetCustomHolidays_Success(t *testing.T) {
+	service := &MockCustomHolidayService{
+		GetAllCustomHolidaysFunc: func() ([]domain.CustomHoliday, error) {
+			return []domain.CustomHoliday{
+				{ID: 1, Name: "創立記念日", Recurring: true},
+				{ID: 2, Name: "年末年始休業", Recurring: true},
+			}, nil
+		},
+	}
+	handler := NewCustomHolidayHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/custom-holidays", nil)
+	w := httptest.NewRecorder()
+
+	handler.GetCustomHolidays(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var holidays []domain.CustomHoliday
+	if err := json.NewDecoder(w.Body).Decode(&holidays); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(holidays) != 2 {
+		t.Errorf("Expected 2 custom holidays, got %d", len(holidays))
+	}
+}
+
+func TestCustomHolidayHandler_GetCustomHoliday_NotFound(t *testing.T) {
+	service := &MockCustomHolidayService{}
+	handler := NewCustomHolidayHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/custom-holidays/999", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "999"})
+	w := httptest.NewRecorder()
+
+	handler.GetCustomHoliday(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status code %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestCustomHolidayHandler_CreateCustomHoliday_Success(t *testing.T) {
+	service := &MockCustomHolidayService{
+		CreateCustomHolidayFunc: func(holiday *domain.CustomHoliday) error {
+			holiday.ID = 1
+			return nil
+		},
+	}
+	handler := NewCustomHolidayHandler(service)
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"name":       "年末年始休業",
+		"start_date": time.Date(2025, 12, 29, 0, 0, 0, 0, time.UTC).Format(time.RFC3339),
+		"end_date":   time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC).Format(time.RFC3339),
+		"recurring":  true,
+	})
+	req := httptest.NewRequest(http.MethodPost, "/api/custom-holidays", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+
+	handler.CreateCustomHoliday(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Errorf("Expected status code %d, got %d", http.StatusCreated, w.Code)
+	}
+
+	var holiday domain.CustomHoliday
+	if err := json.NewDecoder(w.Body).Decode(&holiday); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if holiday.ID != 1 || !holiday.Recurring {
+		t.Errorf("Unexpected custom holiday: %+v", holiday)
+	}
+}
+
+func TestCustomHolidayHandler_CreateCustomHoliday_InvalidInput(t *testing.T) {
+	service := &MockCustomHolidayService{
+		CreateCustomHolidayFunc: func(holiday *domain.CustomHoliday) error {
+			return domain.ErrInvalidInput
+		},
+	}
+	handler := NewCustomHolidayHandler(service)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/custom-holidays", bytes.NewReader([]byte(`{"name":""}`)))
+	w := httptest.NewRecorder()
+
+	handler.CreateCustomHoliday(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status code %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestCustomHolidayHandler_UpdateCustomHoliday_NotFound(t *testing.T) {
+	service := &MockCustomHolidayService{
+		UpdateCustomHolidayFunc: func(holiday *domain.CustomHoliday) error {
+			if holiday.ID != 999 {
+				t.Errorf("Expected ID 999, got %d", holiday.ID)
+			}
+			return domain.ErrNotFound
+		},
+	}
+	handler := NewCustomHolidayHandler(service)
+
+	req := httptest.NewRequest(http.MethodPut, "/api/custom-holidays/999", bytes.NewReader([]byte(`{"name":"創立記念日"}`)))
+	req = mux.SetURLVars(req, map[string]string{"id": "999"})
+	w := httptest.NewRecorder()
+
+	handler.UpdateCustomHoliday(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status code %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestCustomHolidayHandler_DeleteCustomHoliday_Success(t *testing.T) {
+	service := &MockCustomHolidayService{}
+	handler := NewCustomHolidayHandler(service)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/custom-holidays/1", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "1"})
+	w := httptest.NewRecorder()
+
+	handler.DeleteCustomHoliday(w, req)
+
+	if w.Code != http.StatusNoContent {
+		t.Errorf("Expected status code %d, got %d", http.StatusNoContent, w.Code)
+	}
+}
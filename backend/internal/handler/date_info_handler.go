@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// DateInfoServiceInterface は日付情報サービスのインターフェース
+type DateInfoServiceInterface interface {
+	GetDateInfo(dates []time.Time, opts domain.BusinessDayOptions, lang string) ([]domain.DateInfo, error)
+}
+
+type DateInfoHandler struct {
+	service DateInfoServiceInterface
+}
+
+func NewDateInfoHandler(service DateInfoServiceInterface) *DateInfoHandler {
+	return &DateInfoHandler{service: service}
+}
+
+// dateInfoRequest 日付情報の一括取得のリクエスト
+type dateInfoRequest struct {
+	Dates []string `json:"dates"`
+}
+
+// GetDateInfo 複数の日付の曜日・六曜・祝日・営業日かどうかを一括で取得
+// 営業日の判定は weekend・region・custom パラメータ、表示言語は lang パラメータに従う
+func (h *DateInfoHandler) GetDateInfo(w http.ResponseWriter, r *http.Request) {
+	var req dateInfoRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	dates := make([]time.Time, 0, len(req.Dates))
+	for _, value := range req.Dates {
+		date, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			http.Error(w, "Invalid date: "+value, http.StatusBadRequest)
+			return
+		}
+		dates = append(dates, date)
+	}
+
+	opts, err := parseBusinessDayOptions(r)
+	if err != nil {
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+	lang, err := parseLanguage(r)
+	if err != nil {
+		http.Error(w, "Invalid lang", http.StatusBadRequest)
+		return
+	}
+
+	infos, err := h.service.GetDateInfo(dates, opts, lang)
+	if err == domain.ErrInvalidInput {
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	setLanguageHeaders(w, lang)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(infos)
+}
@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// MockDateInfoService はテスト用のモックサービス
+type MockDateInfoService struct {
+	GetDateInfoFunc func(dates []time.Time, opts domain.BusinessDayOptions, lang string) ([]domain.DateInfo, error)
+}
+
+func (m *MockDateInfoService) GetDateInfo(dates []time.Time, opts domain.BusinessDayOptions, lang string) ([]domain.DateInfo, error) {
+	if m.GetDateInfoFunc != nil {
+		return m.GetDateInfoFunc(dates, opts, lang)
+	}
+	return []domain.DateInfo{}, nil
+}
+
+func TestDateInfoHandler_GetDateInfo(t *testing.T) {
+	var gotDates []time.Time
+	var gotOpts domain.BusinessDayOptions
+	var gotLang string
+	service := &MockDateInfoService{
+		GetDateInfoFunc: func(dates []time.Time, opts domain.BusinessDayOptions, lang string) ([]domain.DateInfo, error) {
+			gotDates, gotOpts, gotLang = dates, opts, lang
+			infos := []domain.DateInfo{}
+			for _, date := range dates {
+				infos = append(infos, domain.DateInfo{Date: date, Holidays: []domain.Holiday{}})
+			}
+			return infos, nil
+		},
+	}
+	handler := NewDateInfoHandler(service)
+
+	body := strings.NewReader(`{"dates":["2025-01-01","2025-05-06"]}`)
+	req := httptest.NewRequest(http.MethodPost, "/api/dates/info?region=JP,US&custom=true&weekend=fri&lang=en", body)
+	w := httptest.NewRecorder()
+
+	handler.GetDateInfo(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+	if len(gotDates) != 2 || !gotDates[1].Equal(time.Date(2025, 5, 6, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected dates: %v", gotDates)
+	}
+	if len(gotOpts.Regions) != 2 || !gotOpts.IncludeCustom || len(gotOpts.Weekend) != 1 || gotOpts.Weekend[0] != time.Friday {
+		t.Errorf("Unexpected options: %+v", gotOpts)
+	}
+	if gotLang != domain.LanguageEnglish {
+		t.Errorf("Expected lang en, got %q", gotLang)
+	}
+
+	var infos []domain.DateInfo
+	if err := json.NewDecoder(w.Body).Decode(&infos); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(infos) != 2 {
+		t.Errorf("Expected 2 results, got %d", len(infos))
+	}
+}
+
+func TestDateInfoHandler_GetDateInfo_InvalidRequest(t *testing.T) {
+	service := &MockDateInfoService{
+		GetDateInfoFunc: func(dates []time.Time, opts domain.BusinessDayOptions, lang string) ([]domain.DateInfo, error) {
+			return nil, domain.ErrInvalidInput
+		},
+	}
+	handler := NewDateInfoHandler(service)
+
+	tests := []struct {
+		query string
+		body  string
+	}{
+		{"", `invalid json`},
+		{"", `{"dates":["2025/01/01"]}`},
+		{"?weekend=xyz", `{"dates":["2025-01-01"]}`},
+		{"?lang=xx", `{"dates":["2025-01-01"]}`},
+		{"", `{"dates":[]}`},
+	}
+	for _, test := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/api/dates/info"+test.query, strings.NewReader(test.body))
+		w := httptest.NewRecorder()
+
+		handler.GetDateInfo(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%q %s: Expected status code %d, got %d", test.query, test.body, http.StatusBadRequest, w.Code)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// EraServiceInterface は和暦サービスのインターフェース
+type EraServiceInterface interface {
+	GetEras() []domain.Era
+	ToJapaneseDate(date time.Time) (*domain.JapaneseDate, error)
+	FromJapaneseDate(era string, eraYear, month, day int) (*domain.JapaneseDate, error)
+	ParseJapaneseDate(text string) (*domain.JapaneseDate, error)
+}
+
+type EraHandler struct {
+	service EraServiceInterface
+}
+
+func NewEraHandler(service EraServiceInterface) *EraHandler {
+	return &EraHandler{service: service}
+}
+
+// GetEras 元号一覧取得
+func (h *EraHandler) GetEras(w http.ResponseWriter, r *http.Request) {
+	eras := h.service.GetEras()
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(eras)
+}
+
+// ToJapaneseDate 西暦から和暦への変換
+func (h *EraHandler) ToJapaneseDate(w http.ResponseWriter, r *http.Request) {
+	date, err := time.Parse("2006-01-02", r.URL.Query().Get("date"))
+	if err != nil {
+		http.Error(w, "Invalid date", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.service.ToJapaneseDate(date)
+	if err == domain.ErrInvalidInput {
+		http.Error(w, "Date is out of supported era range", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// FromJapaneseDate 和暦から西暦への変換
+// text（「令和元年5月1日」など）または era/year/month/day のいずれかで指定する
+func (h *EraHandler) FromJapaneseDate(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var result *domain.JapaneseDate
+	var err error
+
+	if text := query.Get("text"); text != "" {
+		result, err = h.service.ParseJapaneseDate(text)
+	} else {
+		year, yearErr := strconv.Atoi(query.Get("year"))
+		month, monthErr := strconv.Atoi(query.Get("month"))
+		day, dayErr := strconv.Atoi(query.Get("day"))
+		if query.Get("era") == "" || yearErr != nil || monthErr != nil || dayErr != nil {
+			http.Error(w, "Invalid parameters", http.StatusBadRequest)
+			return
+		}
+		result, err = h.service.FromJapaneseDate(query.Get("era"), year, month, day)
+	}
+
+	if err == domain.ErrInvalidInput {
+		http.Error(w, "Invalid Japanese era date", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// MockEraService はテスト用のモックサービス
+type MockEraService struct {
+	GetErasFunc           func() []domain.Era
+	ToJapaneseDateFunc    func(date time.Time) (*domain.JapaneseDate, error)
+	FromJapaneseDateFunc  func(era string, eraYear, month, day int) (*domain.JapaneseDate, error)
+	ParseJapaneseDateFunc func(text string) (*domain.JapaneseDate, error)
+}
+
+func (m *MockEraService) GetEras() []domain.Era {
+	if m.GetErasFunc != nil {
+		return m.GetErasFunc()
+	}
+	return []domain.Era{}
+}
+
+func (m *MockEraService) ToJapaneseDate(date time.Time) (*domain.JapaneseDate, error) {
+	if m.ToJapaneseDateFunc != nil {
+		return m.ToJapaneseDateFunc(date)
+	}
+	return nil, nil
+}
+
+func (m *MockEraService) FromJapaneseDate(era string, eraYear, month, day int) (*domain.JapaneseDate, error) {
+	if m.FromJapaneseDateFunc != nil {
+		return m.FromJapaneseDateFunc(era, eraYear, month, day)
+	}
+	return nil, nil
+}
+
+func (m *MockEraService) ParseJapaneseDate(text string) (*domain.JapaneseDate, error) {
+	if m.ParseJapaneseDateFunc != nil {
+		return m.ParseJapaneseDateFunc(text)
+	}
+	return nil, nil
+}
+
+func TestNewEraHandler(t *testing.T) {
+	handler := NewEraHandler(&MockEraService{})
+
+	if handler == nil {
+		t.Error("NewEraHandler should return a non-nil handler")
+	}
+}
+
+func TestEraHandler_ToJapaneseDate_Success(t *testing.T) {
+	service := &MockEraService{
+		ToJapaneseDateFunc: func(date time.Time) (*domain.JapaneseDate, error) {
+			return &domain.JapaneseDate{Date: date, Era: "令和", EraYear: 1, Month: 5, Day: 1, Formatted: "令和元年5月1日"}, nil
+		},
+	}
+	handler := NewEraHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/wareki?date=2019-05-01", nil)
+	w := httptest.NewRecorder()
+
+	handler.ToJapaneseDate(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var result domain.JapaneseDate
+	if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	if result.Formatted != "令和元年5月1日" {
+		t.Errorf("Expected '令和元年5月1日', got '%s'", result.Formatted)
+	}
+}
+
+func TestEraHandler_ToJapaneseDate_InvalidDate(t *testing.T) {
+	handler := NewEraHandler(&MockEraService{})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/wareki?date=invalid", nil)
+	w := httptest.NewRecorder()
+
+	handler.ToJapaneseDate(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status code %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestEraHandler_ToJapaneseDate_OutOfRange(t *testing.T) {
+	service := &MockEraService{
+		ToJapaneseDateFunc: func(date time.Time) (*domain.JapaneseDate, error) {
+			return nil, domain.ErrInvalidInput
+		},
+	}
+	handler := NewEraHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/wareki?date=1800-01-01", nil)
+	w := httptest.NewRecorder()
+
+	handler.ToJapaneseDate(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status code %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestEraHandler_FromJapaneseDate_Text(t *testing.T) {
+	var receivedText string
+	service := &MockEraService{
+		ParseJapaneseDateFunc: func(text string) (*domain.JapaneseDate, error) {
+			receivedText = text
+			return &domain.JapaneseDate{Date: time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC), Era: "令和", EraYear: 1}, nil
+		},
+	}
+	handler := NewEraHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/wareki/gregorian?text=%E4%BB%A4%E5%92%8C%E5%85%83%E5%B9%B45%E6%9C%881%E6%97%A5", nil)
+	w := httptest.NewRecorder()
+
+	handler.FromJapaneseDate(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+	if receivedText != "令和元年5月1日" {
+		t.Errorf("Expected text '令和元年5月1日', got '%s'", receivedText)
+	}
+}
+
+func TestEraHandler_FromJapaneseDate_Fields(t *testing.T) {
+	service := &MockEraService{
+		FromJapaneseDateFunc: func(era string, eraYear, month, day int) (*domain.JapaneseDate, error) {
+			if era == "平成" && eraYear == 31 && month == 5 {
+				return nil, domain.ErrInvalidInput
+			}
+			return &domain.JapaneseDate{Era: era, EraYear: eraYear, Month: month, Day: day}, nil
+		},
+	}
+	handler := NewEraHandler(service)
+
+	tests := []struct {
+		query    string
+		expected int
+	}{
+		{"era=R&year=7&month=12&day=3", http.StatusOK},
+		{"era=%E5%B9%B3%E6%88%90&year=31&month=5&day=1", http.StatusBadRequest},
+		{"year=7&month=12&day=3", http.StatusBadRequest},
+		{"era=R&year=x&month=12&day=3", http.StatusBadRequest},
+	}
+
+	for _, test := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/api/wareki/gregorian?"+test.query, nil)
+		w := httptest.NewRecorder()
+
+		handler.FromJapaneseDate(w, req)
+
+		if w.Code != test.expected {
+			t.Errorf("%s: expected status code %d, got %d", test.query, test.expected, w.Code)
+		}
+	}
+}
+
+func TestEraHandler_GetEras(t *testing.T) {
+	service := &MockEraService{
+		GetErasFunc: func() []domain.Era {
+			return []domain.Era{{Name: "令和", Romaji: "Reiwa"}}
+		},
+	}
+	handler := NewEraHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/eras", nil)
+	w := httptest.NewRecorder()
+
+	handler.GetEras(w, req)
+
+	var eras []domain.Era
+	if err := json.NewDecoder(w.Body).Decode(&eras); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(eras) != 1 || eras[0].Name != "令和" {
+		t.Errorf("Unexpected eras: %v", eras)
+	}
+}
@@ -2,8 +2,11 @@ package handler
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
@@ -11,10 +14,13 @@ import (
 
 // EventServiceInterface はイベントサービスのインターフェース
 type EventServiceInterface interface {
-	GetAllEvents() ([]domain.Event, error)
+	ListEvents(opts domain.EventListOptions) (*domain.EventPage, error)
+	SearchEvents(query string, limit int) ([]domain.EventSearchResult, error)
 	GetEventByID(id int) (*domain.Event, error)
+	GetEventsByDateRange(start, end time.Time) ([]domain.Event, error)
 	CreateEvent(event *domain.Event) error
 	UpdateEvent(event *domain.Event) error
+	UpdateEventOccurrences(event *domain.Event, scope string, recurrenceID time.Time) error
 	DeleteEvent(id int) error
 }
 
@@ -26,18 +32,95 @@ func NewEventHandler(service EventServiceInterface) *EventHandler {
 	return &EventHandler{service: service}
 }
 
-// GetEvents 全イベント取得
+// GetEvents イベント一覧取得
+// sort（start_date / updated_at / title、先頭に - を付けると降順）で並べ、limit 件ずつ返す。
+// 次のページがある場合は Link ヘッダー（rel="next"）に cursor を付けたURLを返す。
+// all_day・updated_since・title_prefix で絞り込める
+// start・end を指定した場合は期間と重なるイベント（繰り返しイベントは期間内の各回）をすべて返す
+// tz を指定した場合は日時をそのタイムゾーンで返す
 func (h *EventHandler) GetEvents(w http.ResponseWriter, r *http.Request) {
-	events, err := h.service.GetAllEvents()
+	loc, err := parseTimeZone(r)
+	if err != nil {
+		http.Error(w, "Invalid tz", http.StatusBadRequest)
+		return
+	}
+
+	start, end, ranged, err := parseEventDateRange(r, loc)
+	if err != nil {
+		http.Error(w, "Invalid start or end", http.StatusBadRequest)
+		return
+	}
+	opts, err := parseEventListOptions(r)
+	if err != nil || (ranged && opts != (domain.EventListOptions{})) {
+		http.Error(w, "Invalid list parameters", http.StatusBadRequest)
+		return
+	}
+
+	var events []domain.Event
+	if ranged {
+		events, err = h.service.GetEventsByDateRange(start, end)
+	} else {
+		var page *domain.EventPage
+		page, err = h.service.ListEvents(opts)
+		if err == nil {
+			events = page.Events
+			if page.NextCursor != "" {
+				w.Header().Set("Link", nextPageLink(r, page.NextCursor))
+			}
+		}
+	}
+	if err == domain.ErrInvalidInput {
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	for i := range events {
+		eventIn(&events[i], loc)
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(events)
 }
 
+// SearchEvents イベント検索
+// q の検索語（空白区切り）をすべてタイトルか説明に含むイベントを関連度の高い順に limit 件返す
+// tz を指定した場合は日時をそのタイムゾーンで返す
+func (h *EventHandler) SearchEvents(w http.ResponseWriter, r *http.Request) {
+	loc, err := parseTimeZone(r)
+	if err != nil {
+		http.Error(w, "Invalid tz", http.StatusBadRequest)
+		return
+	}
+
+	limit := 0
+	if value := r.URL.Query().Get("limit"); value != "" {
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			http.Error(w, "Invalid limit", http.StatusBadRequest)
+			return
+		}
+	}
+
+	results, err := h.service.SearchEvents(r.URL.Query().Get("q"), limit)
+	if err == domain.ErrInvalidInput {
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	for i := range results {
+		eventIn(&results[i].Event, loc)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(results)
+}
+
 // GetEvent 単一イベント取得
 func (h *EventHandler) GetEvent(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
@@ -47,6 +130,12 @@ func (h *EventHandler) GetEvent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	loc, err := parseTimeZone(r)
+	if err != nil {
+		http.Error(w, "Invalid tz", http.StatusBadRequest)
+		return
+	}
+
 	event, err := h.service.GetEventByID(id)
 	if err == domain.ErrNotFound {
 		http.Error(w, "Event not found", http.StatusNotFound)
@@ -61,18 +150,29 @@ func (h *EventHandler) GetEvent(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Event not found", http.StatusNotFound)
 		return
 	}
+	eventIn(event, loc)
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(event)
 }
 
 // CreateEvent イベント作成
+// time_zone を省略した場合は tz パラメータのタイムゾーンをイベントのタイムゾーンとする
 func (h *EventHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
+	loc, err := parseTimeZone(r)
+	if err != nil {
+		http.Error(w, "Invalid tz", http.StatusBadRequest)
+		return
+	}
+
 	var event domain.Event
 	if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
+	if event.TimeZone == "" && loc != nil {
+		event.TimeZone = loc.String()
+	}
 
 	if err := h.service.CreateEvent(&event); err != nil {
 		if err == domain.ErrInvalidInput {
@@ -82,6 +182,7 @@ func (h *EventHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	eventIn(&event, loc)
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
@@ -89,6 +190,8 @@ func (h *EventHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
 }
 
 // UpdateEvent イベント更新
+// 繰り返しイベントは scope（this: この回のみ、following: この回以降、all: すべての回）と
+// recurrence_id（変更する回の本来の開始日時、RFC 3339）で変更する範囲を指定できる
 func (h *EventHandler) UpdateEvent(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	id, err := strconv.Atoi(vars["id"])
@@ -97,6 +200,12 @@ func (h *EventHandler) UpdateEvent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	loc, err := parseTimeZone(r)
+	if err != nil {
+		http.Error(w, "Invalid tz", http.StatusBadRequest)
+		return
+	}
+
 	var event domain.Event
 	if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
@@ -104,8 +213,29 @@ func (h *EventHandler) UpdateEvent(w http.ResponseWriter, r *http.Request) {
 	}
 
 	event.ID = id
+	if event.TimeZone == "" && loc != nil {
+		event.TimeZone = loc.String()
+	}
 
-	if err := h.service.UpdateEvent(&event); err != nil {
+	scope := r.URL.Query().Get("scope")
+	if scope != "" && scope != domain.EventEditScopeAll {
+		recurrenceID, err := time.Parse(time.RFC3339, r.URL.Query().Get("recurrence_id"))
+		if err != nil {
+			http.Error(w, "Invalid recurrence_id", http.StatusBadRequest)
+			return
+		}
+		err = h.service.UpdateEventOccurrences(&event, scope, recurrenceID)
+		writeUpdatedEvent(w, &event, loc, err)
+		return
+	}
+
+	err = h.service.UpdateEvent(&event)
+	writeUpdatedEvent(w, &event, loc, err)
+}
+
+// writeUpdatedEvent 更新後のイベントまたはエラーを書き込む
+func writeUpdatedEvent(w http.ResponseWriter, event *domain.Event, loc *time.Location, err error) {
+	if err != nil {
 		if err == domain.ErrNotFound {
 			http.Error(w, "Event not found", http.StatusNotFound)
 			return
@@ -117,6 +247,7 @@ func (h *EventHandler) UpdateEvent(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	eventIn(event, loc)
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(event)
@@ -142,3 +273,97 @@ func (h *EventHandler) DeleteEvent(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// eventIn イベントの日時を指定したタイムゾーンで表す（nil の場合はそのまま）
+func eventIn(event *domain.Event, loc *time.Location) {
+	if loc == nil {
+		return
+	}
+	event.StartDate = event.StartDate.In(loc)
+	event.EndDate = event.EndDate.In(loc)
+	event.CreatedAt = event.CreatedAt.In(loc)
+	event.UpdatedAt = event.UpdatedAt.In(loc)
+}
+
+// parseEventDateRange start・end パラメータ（RFC 3339 または 2006-01-02）を取得
+// 日付のみの場合は loc（省略時はUTC）のその日の始まり・終わりとする。どちらも省略した場合は ranged が false
+func parseEventDateRange(r *http.Request, loc *time.Location) (start, end time.Time, ranged bool, err error) {
+	startParam, endParam := r.URL.Query().Get("start"), r.URL.Query().Get("end")
+	if startParam == "" && endParam == "" {
+		return time.Time{}, time.Time{}, false, nil
+	}
+	if startParam == "" || endParam == "" {
+		return time.Time{}, time.Time{}, false, domain.ErrInvalidInput
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	start, err = parseEventDateTime(startParam, loc, false)
+	if err != nil {
+		return time.Time{}, time.Time{}, false, err
+	}
+	end, err = parseEventDateTime(endParam, loc, true)
+	if err != nil {
+		return time.Time{}, time.Time{}, false, err
+	}
+	return start, end, true, nil
+}
+
+// parseEventDateTime RFC 3339 の日時、または日付（endOfDay の場合はその日の終わり）を解析
+func parseEventDateTime(value string, loc *time.Location, endOfDay bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	date, err := time.ParseInLocation("2006-01-02", value, loc)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if endOfDay {
+		return date.AddDate(0, 0, 1).Add(-time.Nanosecond), nil
+	}
+	return date, nil
+}
+
+// parseEventListOptions イベント一覧の sort・limit・cursor・all_day・updated_since・title_prefix パラメータを取得
+func parseEventListOptions(r *http.Request) (domain.EventListOptions, error) {
+	query := r.URL.Query()
+	opts := domain.EventListOptions{
+		Cursor:      query.Get("cursor"),
+		TitlePrefix: query.Get("title_prefix"),
+	}
+
+	if sort := query.Get("sort"); sort != "" {
+		opts.Sort = strings.TrimPrefix(sort, "-")
+		opts.Desc = strings.HasPrefix(sort, "-")
+	}
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			return opts, domain.ErrInvalidInput
+		}
+		opts.Limit = limit
+	}
+	if value := query.Get("all_day"); value != "" {
+		allDay, err := strconv.ParseBool(value)
+		if err != nil {
+			return opts, domain.ErrInvalidInput
+		}
+		opts.AllDay = &allDay
+	}
+	if value := query.Get("updated_since"); value != "" {
+		since, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return opts, domain.ErrInvalidInput
+		}
+		opts.UpdatedSince = &since
+	}
+	return opts, nil
+}
+
+// nextPageLink 次のページの Link ヘッダー（リクエストのパラメータの cursor を置き換えたURL）
+func nextPageLink(r *http.Request, cursor string) string {
+	query := r.URL.Query()
+	query.Set("cursor", cursor)
+	return fmt.Sprintf(`<%s?%s>; rel="next"`, r.URL.Path, query.Encode())
+}
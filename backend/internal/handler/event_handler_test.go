@@ -15,18 +15,28 @@ import (
 
 // MockEventService はテスト用のモックサービス
 type MockEventService struct {
-	GetAllEventsFunc func() ([]domain.Event, error)
-	GetEventByIDFunc func(id int) (*domain.Event, error)
-	CreateEventFunc  func(event *domain.Event) error
-	UpdateEventFunc  func(event *domain.Event) error
-	DeleteEventFunc  func(id int) error
+	ListEventsFunc             func(opts domain.EventListOptions) (*domain.EventPage, error)
+	SearchEventsFunc           func(query string, limit int) ([]domain.EventSearchResult, error)
+	GetEventByIDFunc           func(id int) (*domain.Event, error)
+	GetEventsByDateRangeFunc   func(start, end time.Time) ([]domain.Event, error)
+	CreateEventFunc            func(event *domain.Event) error
+	UpdateEventFunc            func(event *domain.Event) error
+	DeleteEventFunc            func(id int) error
+	UpdateEventOccurrencesFunc func(event *domain.Event, scope string, recurrenceID time.Time) error
 }
 
-func (m *MockEventService) GetAllEvents() ([]domain.Event, error) {
-	if m.GetAllEventsFunc != nil {
-		return m.GetAllEventsFunc()
+func (m *MockEventService) ListEvents(opts domain.EventListOptions) (*domain.EventPage, error) {
+	if m.ListEventsFunc != nil {
+		return m.ListEventsFunc(opts)
 	}
-	return []domain.Event{}, nil
+	return &domain.EventPage{Events: []domain.Event{}}, nil
+}
+
+func (m *MockEventService) SearchEvents(query string, limit int) ([]domain.EventSearchResult, error) {
+	if m.SearchEventsFunc != nil {
+		return m.SearchEventsFunc(query, limit)
+	}
+	return []domain.EventSearchResult{}, nil
 }
 
 func (m *MockEventService) GetEventByID(id int) (*domain.Event, error) {
@@ -36,6 +46,13 @@ func (m *MockEventService) GetEventByID(id int) (*domain.Event, error) {
 	return nil, nil
 }
 
+func (m *MockEventService) GetEventsByDateRange(start, end time.Time) ([]domain.Event, error) {
+	if m.GetEventsByDateRangeFunc != nil {
+		return m.GetEventsByDateRangeFunc(start, end)
+	}
+	return []domain.Event{}, nil
+}
+
 func (m *MockEventService) CreateEvent(event *domain.Event) error {
 	if m.CreateEventFunc != nil {
 		return m.CreateEventFunc(event)
@@ -50,6 +67,13 @@ func (m *MockEventService) UpdateEvent(event *domain.Event) error {
 	return nil
 }
 
+func (m *MockEventService) UpdateEventOccurrences(event *domain.Event, scope string, recurrenceID time.Time) error {
+	if m.UpdateEventOccurrencesFunc != nil {
+		return m.UpdateEventOccurrencesFunc(event, scope, recurrenceID)
+	}
+	return nil
+}
+
 func (m *MockEventService) DeleteEvent(id int) error {
 	if m.DeleteEventFunc != nil {
 		return m.DeleteEventFunc(id)
@@ -87,8 +111,8 @@ func TestEventHandler_GetAllEvents_Success(t *testing.T) {
 	}
 
 	service := &MockEventService{
-		GetAllEventsFunc: func() ([]domain.Event, error) {
-			return mockEvents, nil
+		ListEventsFunc: func(opts domain.EventListOptions) (*domain.EventPage, error) {
+			return &domain.EventPage{Events: mockEvents}, nil
 		},
 	}
 
@@ -115,7 +139,7 @@ func TestEventHandler_GetAllEvents_Success(t *testing.T) {
 
 func TestEventHandler_GetAllEvents_Error(t *testing.T) {
 	service := &MockEventService{
-		GetAllEventsFunc: func() ([]domain.Event, error) {
+		ListEventsFunc: func(opts domain.EventListOptions) (*domain.EventPage, error) {
 			return nil, errors.New("database error")
 		},
 	}
@@ -339,6 +363,78 @@ func TestEventHandler_UpdateEvent_NotFound(t *testing.T) {
 	}
 }
 
+func TestEventHandler_UpdateEvent_Scope(t *testing.T) {
+	var gotScope string
+	var gotRecurrenceID time.Time
+	service := &MockEventService{
+		UpdateEventFunc: func(event *domain.Event) error {
+			t.Error("UpdateEvent should not be called for scope=this")
+			return nil
+		},
+		UpdateEventOccurrencesFunc: func(event *domain.Event, scope string, recurrenceID time.Time) error {
+			gotScope, gotRecurrenceID = scope, recurrenceID
+			seriesID := event.ID
+			event.ID = 2
+			event.RecurringEventID = &seriesID
+			event.RecurrenceID = &recurrenceID
+			return nil
+		},
+	}
+	handler := NewEventHandler(service)
+
+	body := `{"title":"定例会（会議室変更）","start_date":"2025-01-13T10:00:00+09:00","end_date":"2025-01-13T11:00:00+09:00"}`
+	req := httptest.NewRequest(http.MethodPut, "/api/events/1?scope=this&recurrence_id=2025-01-13T10:00:00%2B09:00", bytes.NewBufferString(body))
+	req = mux.SetURLVars(req, map[string]string{"id": "1"})
+	w := httptest.NewRecorder()
+
+	handler.UpdateEvent(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+	if gotScope != domain.EventEditScopeThis {
+		t.Errorf("Expected scope this, got %q", gotScope)
+	}
+	if !gotRecurrenceID.Equal(time.Date(2025, 1, 13, 1, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected recurrence_id: %v", gotRecurrenceID)
+	}
+
+	var event domain.Event
+	if err := json.NewDecoder(w.Body).Decode(&event); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if event.ID != 2 || event.RecurringEventID == nil || *event.RecurringEventID != 1 {
+		t.Errorf("Expected detached event 2 of series 1, got %+v", event)
+	}
+}
+
+func TestEventHandler_UpdateEvent_InvalidScope(t *testing.T) {
+	service := &MockEventService{
+		UpdateEventOccurrencesFunc: func(event *domain.Event, scope string, recurrenceID time.Time) error {
+			return domain.ErrInvalidInput
+		},
+	}
+	handler := NewEventHandler(service)
+
+	body := `{"title":"定例会","start_date":"2025-01-13T10:00:00+09:00","end_date":"2025-01-13T11:00:00+09:00"}`
+	queries := []string{
+		"?scope=following",
+		"?scope=this&recurrence_id=2025-01-13",
+		"?scope=other&recurrence_id=2025-01-13T10:00:00Z",
+	}
+	for _, query := range queries {
+		req := httptest.NewRequest(http.MethodPut, "/api/events/1"+query, bytes.NewBufferString(body))
+		req = mux.SetURLVars(req, map[string]string{"id": "1"})
+		w := httptest.NewRecorder()
+
+		handler.UpdateEvent(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%q: Expected status code %d, got %d", query, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
 func TestEventHandler_DeleteEvent_Success(t *testing.T) {
 	service := &MockEventService{
 		DeleteEventFunc: func(id int) error {
@@ -378,3 +474,314 @@ func TestEventHandler_DeleteEvent_NotFound(t *testing.T) {
 		t.Errorf("Expected status code %d, got %d", http.StatusNotFound, w.Code)
 	}
 }
+
+func TestEventHandler_GetAllEvents_TimeZone(t *testing.T) {
+	service := &MockEventService{
+		ListEventsFunc: func(opts domain.EventListOptions) (*domain.EventPage, error) {
+			return &domain.EventPage{Events: []domain.Event{
+				{
+					ID:        1,
+					Title:     "会議",
+					StartDate: time.Date(2025, 12, 10, 14, 30, 0, 0, time.UTC),
+					EndDate:   time.Date(2025, 12, 10, 15, 30, 0, 0, time.UTC),
+					TimeZone:  "Asia/Tokyo",
+				},
+			}}, nil
+		},
+	}
+	handler := NewEventHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/events?tz=Asia/Tokyo", nil)
+	w := httptest.NewRecorder()
+
+	handler.GetEvents(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var events []map[string]interface{}
+	if err := json.NewDecoder(w.Body).Decode(&events); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if events[0]["start_date"] != "2025-12-10T23:30:00+09:00" {
+		t.Errorf("Expected start_date in JST, got %v", events[0]["start_date"])
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/events?tz=Invalid/Zone", nil)
+	w = httptest.NewRecorder()
+
+	handler.GetEvents(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status code %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestEventHandler_CreateEvent_TimeZoneParameter(t *testing.T) {
+	var got string
+	service := &MockEventService{
+		CreateEventFunc: func(event *domain.Event) error {
+			got = event.TimeZone
+			return nil
+		},
+	}
+	handler := NewEventHandler(service)
+
+	tests := []struct {
+		url      string
+		body     string
+		expected string
+	}{
+		{"/api/events?tz=America/New_York", `{"title":"会議","start_date":"2025-12-10T09:30:00-05:00","end_date":"2025-12-10T10:30:00-05:00"}`, "America/New_York"},
+		{"/api/events?tz=America/New_York", `{"title":"会議","start_date":"2025-12-10T09:30:00-05:00","end_date":"2025-12-10T10:30:00-05:00","time_zone":"Europe/London"}`, "Europe/London"},
+		{"/api/events", `{"title":"会議","start_date":"2025-12-10T09:30:00+09:00","end_date":"2025-12-10T10:30:00+09:00"}`, ""},
+	}
+
+	for _, test := range tests {
+		req := httptest.NewRequest(http.MethodPost, test.url, bytes.NewBufferString(test.body))
+		w := httptest.NewRecorder()
+
+		handler.CreateEvent(w, req)
+
+		if w.Code != http.StatusCreated {
+			t.Fatalf("%s: Expected status code %d, got %d", test.url, http.StatusCreated, w.Code)
+		}
+		if got != test.expected {
+			t.Errorf("%s: Expected time zone %q, got %q", test.url, test.expected, got)
+		}
+	}
+}
+
+func TestEventHandler_GetEvents_DateRange(t *testing.T) {
+	var gotStart, gotEnd time.Time
+	service := &MockEventService{
+		ListEventsFunc: func(opts domain.EventListOptions) (*domain.EventPage, error) {
+			t.Error("ListEvents should not be called when start and end are given")
+			return nil, nil
+		},
+		GetEventsByDateRangeFunc: func(start, end time.Time) ([]domain.Event, error) {
+			gotStart, gotEnd = start, end
+			return []domain.Event{{ID: 1, Title: "定例会", StartDate: start, EndDate: start.Add(time.Hour)}}, nil
+		},
+	}
+	handler := NewEventHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/events?start=2025-01-01&end=2025-01-31&tz=Asia/Tokyo", nil)
+	w := httptest.NewRecorder()
+	handler.GetEvents(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+	// 日付のみの指定は tz のその日の始まり・終わり
+	if !gotStart.Equal(time.Date(2024, 12, 31, 15, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected start: %v", gotStart)
+	}
+	if !gotEnd.Equal(time.Date(2025, 1, 31, 14, 59, 59, 999999999, time.UTC)) {
+		t.Errorf("Unexpected end: %v", gotEnd)
+	}
+
+	var events []domain.Event
+	if err := json.NewDecoder(w.Body).Decode(&events); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(events) != 1 {
+		t.Errorf("Expected 1 event, got %d", len(events))
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/events?start=2025-01-01T00:00:00Z&end=2025-01-08T00:00:00%2B09:00", nil)
+	w = httptest.NewRecorder()
+	handler.GetEvents(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+	if !gotStart.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)) || !gotEnd.Equal(time.Date(2025, 1, 7, 15, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected range: %v - %v", gotStart, gotEnd)
+	}
+}
+
+func TestEventHandler_GetEvents_InvalidDateRange(t *testing.T) {
+	service := &MockEventService{
+		GetEventsByDateRangeFunc: func(start, end time.Time) ([]domain.Event, error) {
+			return nil, domain.ErrInvalidInput
+		},
+	}
+	handler := NewEventHandler(service)
+
+	queries := []string{
+		"start=2025-01-01",
+		"end=2025-01-31",
+		"start=2025/01/01&end=2025-01-31",
+		"start=2025-01-01&end=tomorrow",
+		"start=2025-01-01&end=2025-01-31&sort=title",
+		// 期間の上限を超える場合はサービスが ErrInvalidInput を返す
+		"start=2025-01-01&end=2027-01-01",
+	}
+	for _, query := range queries {
+		req := httptest.NewRequest(http.MethodGet, "/api/events?"+query, nil)
+		w := httptest.NewRecorder()
+		handler.GetEvents(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: Expected status code %d, got %d", query, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+func TestEventHandler_GetEvents_Pagination(t *testing.T) {
+	var got domain.EventListOptions
+	service := &MockEventService{
+		ListEventsFunc: func(opts domain.EventListOptions) (*domain.EventPage, error) {
+			got = opts
+			return &domain.EventPage{Events: []domain.Event{{ID: 1, Title: "定例会"}}, NextCursor: "abc"}, nil
+		},
+	}
+	handler := NewEventHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/events?sort=-updated_at&limit=1&all_day=false&updated_since=2025-01-01T00:00:00%2B09:00&title_prefix=%E5%AE%9A%E4%BE%8B", nil)
+	w := httptest.NewRecorder()
+	handler.GetEvents(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+	if got.Sort != domain.EventSortUpdatedAt || !got.Desc || got.Limit != 1 || got.Cursor != "" {
+		t.Errorf("Unexpected sort and limit: %+v", got)
+	}
+	if got.AllDay == nil || *got.AllDay || got.UpdatedSince == nil || !got.UpdatedSince.Equal(time.Date(2024, 12, 31, 15, 0, 0, 0, time.UTC)) || got.TitlePrefix != "定例" {
+		t.Errorf("Unexpected filters: %+v", got)
+	}
+
+	expected := `</api/events?all_day=false&cursor=abc&limit=1&sort=-updated_at&title_prefix=%E5%AE%9A%E4%BE%8B&updated_since=2025-01-01T00%3A00%3A00%2B09%3A00>; rel="next"`
+	if link := w.Header().Get("Link"); link != expected {
+		t.Errorf("Expected Link %s, got %s", expected, link)
+	}
+
+	var events []domain.Event
+	if err := json.NewDecoder(w.Body).Decode(&events); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(events) != 1 {
+		t.Errorf("Expected 1 event, got %d", len(events))
+	}
+
+	// 最後のページには Link ヘッダーを付けない
+	service.ListEventsFunc = func(opts domain.EventListOptions) (*domain.EventPage, error) {
+		got = opts
+		return &domain.EventPage{Events: []domain.Event{}}, nil
+	}
+	req = httptest.NewRequest(http.MethodGet, "/api/events?cursor=abc", nil)
+	w = httptest.NewRecorder()
+	handler.GetEvents(w, req)
+
+	if got.Cursor != "abc" {
+		t.Errorf("Expected the cursor to be passed, got %q", got.Cursor)
+	}
+	if link := w.Header().Get("Link"); link != "" {
+		t.Errorf("Expected no Link header, got %s", link)
+	}
+}
+
+func TestEventHandler_GetEvents_InvalidListParameters(t *testing.T) {
+	service := &MockEventService{
+		ListEventsFunc: func(opts domain.EventListOptions) (*domain.EventPage, error) {
+			if opts.Sort == "created_at" || opts.Cursor != "" {
+				return nil, domain.ErrInvalidInput
+			}
+			return &domain.EventPage{Events: []domain.Event{}}, nil
+		},
+	}
+	handler := NewEventHandler(service)
+
+	queries := []string{
+		"limit=0",
+		"limit=ten",
+		"all_day=maybe",
+		"updated_since=2025-01-01",
+		"sort=created_at",
+		"cursor=broken",
+	}
+	for _, query := range queries {
+		req := httptest.NewRequest(http.MethodGet, "/api/events?"+query, nil)
+		w := httptest.NewRecorder()
+		handler.GetEvents(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: Expected status code %d, got %d", query, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+func TestEventHandler_SearchEvents(t *testing.T) {
+	var gotQuery string
+	var gotLimit int
+	service := &MockEventService{
+		SearchEventsFunc: func(query string, limit int) ([]domain.EventSearchResult, error) {
+			gotQuery, gotLimit = query, limit
+			return []domain.EventSearchResult{
+				{
+					Event: domain.Event{
+						ID:        1,
+						Title:     "定例会議",
+						StartDate: time.Date(2025, 1, 6, 1, 0, 0, 0, time.UTC),
+						EndDate:   time.Date(2025, 1, 6, 2, 0, 0, 0, time.UTC),
+						TimeZone:  "Asia/Tokyo",
+					},
+					Score:      2.5,
+					Highlights: domain.EventHighlights{Title: "<mark>定例</mark>会議"},
+				},
+			}, nil
+		},
+	}
+	handler := NewEventHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/events/search?q=%E3%81%A6%E3%81%84%E3%82%8C%E3%81%84&limit=5&tz=Asia/Tokyo", nil)
+	w := httptest.NewRecorder()
+	handler.SearchEvents(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+	if gotQuery != "ていれい" || gotLimit != 5 {
+		t.Errorf("Unexpected query and limit: %q, %d", gotQuery, gotLimit)
+	}
+
+	var results []map[string]interface{}
+	if err := json.NewDecoder(w.Body).Decode(&results); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("Expected 1 result, got %d", len(results))
+	}
+	event := results[0]["event"].(map[string]interface{})
+	if event["start_date"] != "2025-01-06T10:00:00+09:00" {
+		t.Errorf("Expected start_date in JST, got %v", event["start_date"])
+	}
+	if results[0]["highlights"].(map[string]interface{})["title"] != "<mark>定例</mark>会議" {
+		t.Errorf("Unexpected highlights: %v", results[0]["highlights"])
+	}
+}
+
+func TestEventHandler_SearchEvents_Invalid(t *testing.T) {
+	service := &MockEventService{
+		SearchEventsFunc: func(query string, limit int) ([]domain.EventSearchResult, error) {
+			if query == "" {
+				return nil, domain.ErrInvalidInput
+			}
+			return []domain.EventSearchResult{}, nil
+		},
+	}
+	handler := NewEventHandler(service)
+
+	for _, query := range []string{"", "q=", "q=a&limit=0", "q=a&limit=x", "q=a&tz=Invalid/Zone"} {
+		req := httptest.NewRequest(http.MethodGet, "/api/events/search?"+query, nil)
+		w := httptest.NewRecorder()
+		handler.SearchEvents(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%q: Expected status code %d, got %d", query, http.StatusBadRequest, w.Code)
+		}
+	}
+}
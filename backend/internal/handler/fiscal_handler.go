@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// FiscalServiceInterface は年度サービスのインターフェース
+type FiscalServiceInterface interface {
+	GetFiscalDefinitions() []domain.FiscalYearDefinition
+	GetFiscalDate(date time.Time, opts domain.FiscalOptions) (*domain.FiscalDate, error)
+	GetFiscalYearCalendar(fiscalYear int, opts domain.FiscalOptions, calendarOpts domain.CalendarOptions) (*domain.FiscalYearCalendar, error)
+}
+
+type FiscalHandler struct {
+	service FiscalServiceInterface
+}
+
+func NewFiscalHandler(service FiscalServiceInterface) *FiscalHandler {
+	return &FiscalHandler{service: service}
+}
+
+// GetFiscalDefinitions 年度の定義一覧取得
+func (h *FiscalHandler) GetFiscalDefinitions(w http.ResponseWriter, r *http.Request) {
+	definitions := h.service.GetFiscalDefinitions()
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(definitions)
+}
+
+// GetFiscalDate 指定日の年度・四半期・年度内の月を取得
+func (h *FiscalHandler) GetFiscalDate(w http.ResponseWriter, r *http.Request) {
+	date, err := time.Parse("2006-01-02", r.URL.Query().Get("date"))
+	if err != nil {
+		http.Error(w, "Invalid date", http.StatusBadRequest)
+		return
+	}
+
+	opts, err := parseFiscalOptions(r)
+	if err != nil {
+		http.Error(w, "Invalid start_month", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.service.GetFiscalDate(date, opts)
+	writeFiscalResponse(w, result, err)
+}
+
+// GetFiscalYearCalendar 年度単位のカレンダー取得
+func (h *FiscalHandler) GetFiscalYearCalendar(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	year, err := strconv.Atoi(vars["year"])
+	if err != nil {
+		http.Error(w, "Invalid year", http.StatusBadRequest)
+		return
+	}
+
+	opts, err := parseFiscalOptions(r)
+	if err != nil {
+		http.Error(w, "Invalid start_month", http.StatusBadRequest)
+		return
+	}
+	calendarOpts, err := parseCalendarOptions(r)
+	if err != nil {
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.service.GetFiscalYearCalendar(year, opts, calendarOpts)
+	if err == nil {
+		setLanguageHeaders(w, calendarOpts.Language)
+	}
+	writeFiscalResponse(w, result, err)
+}
+
+// parseFiscalOptions definition・start_month パラメータを解析
+func parseFiscalOptions(r *http.Request) (domain.FiscalOptions, error) {
+	query := r.URL.Query()
+	opts := domain.FiscalOptions{Definition: query.Get("definition")}
+
+	if value := query.Get("start_month"); value != "" {
+		startMonth, err := strconv.Atoi(value)
+		if err != nil {
+			return opts, domain.ErrInvalidInput
+		}
+		opts.StartMonth = startMonth
+	}
+
+	return opts, nil
+}
+
+// writeFiscalResponse 年度の計算結果またはエラーを書き込む
+func writeFiscalResponse(w http.ResponseWriter, result interface{}, err error) {
+	if err == domain.ErrInvalidInput {
+		http.Error(w, "Invalid fiscal year definition", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// MockFiscalService はテスト用のモックサービス
+type MockFiscalService struct {
+	GetFiscalDefinitionsFunc  func() []domain.FiscalYearDefinition
+	GetFiscalDateFunc         func(date time.Time, opts domain.FiscalOptions) (*domain.FiscalDate, error)
+	GetFiscalYearCalendarFunc func(fiscalYear int, opts domain.FiscalOptions, calendarOpts domain.CalendarOptions) (*domain.FiscalYearCalendar, error)
+}
+
+func (m *MockFiscalService) GetFiscalDefinitions() []domain.FiscalYearDefinition {
+	if m.GetFiscalDefinitionsFunc != nil {
+		return m.GetFiscalDefinitionsFunc()
+	}
+	return []domain.FiscalYearDefinition{}
+}
+
+func (m *MockFiscalService) GetFiscalDate(date time.Time, opts domain.FiscalOptions) (*domain.FiscalDate, error) {
+	if m.GetFiscalDateFunc != nil {
+		return m.GetFiscalDateFunc(date, opts)
+	}
+	return &domain.FiscalDate{Date: date}, nil
+}
+
+func (m *MockFiscalService) GetFiscalYearCalendar(fiscalYear int, opts domain.FiscalOptions, calendarOpts domain.CalendarOptions) (*domain.FiscalYearCalendar, error) {
+	if m.GetFiscalYearCalendarFunc != nil {
+		return m.GetFiscalYearCalendarFunc(fiscalYear, opts, calendarOpts)
+	}
+	return &domain.FiscalYearCalendar{FiscalYear: fiscalYear}, nil
+}
+
+func TestFiscalHandler_GetFiscalDate(t *testing.T) {
+	var got domain.FiscalOptions
+	service := &MockFiscalService{
+		GetFiscalDateFunc: func(date time.Time, opts domain.FiscalOptions) (*domain.FiscalDate, error) {
+			got = opts
+			return &domain.FiscalDate{Date: date, FiscalYear: 2025, Quarter: 3, FiscalMonth: 9}, nil
+		},
+	}
+	handler := NewFiscalHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/fiscal/date?date=2025-12-15&definition=school", nil)
+	w := httptest.NewRecorder()
+
+	handler.GetFiscalDate(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+	if got.Definition != "school" || got.StartMonth != 0 {
+		t.Errorf("Unexpected options: %+v", got)
+	}
+
+	var result domain.FiscalDate
+	if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if result.FiscalYear != 2025 || result.Quarter != 3 {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+}
+
+func TestFiscalHandler_GetFiscalDate_InvalidParameters(t *testing.T) {
+	service := &MockFiscalService{
+		GetFiscalDateFunc: func(date time.Time, opts domain.FiscalOptions) (*domain.FiscalDate, error) {
+			return nil, domain.ErrInvalidInput
+		},
+	}
+	handler := NewFiscalHandler(service)
+
+	for _, url := range []string{
+		"/api/fiscal/date",
+		"/api/fiscal/date?date=2025-12-15&start_month=april",
+		"/api/fiscal/date?date=2025-12-15&definition=unknown",
+	} {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		w := httptest.NewRecorder()
+
+		handler.GetFiscalDate(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: Expected status code %d, got %d", url, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+func TestFiscalHandler_GetFiscalYearCalendar(t *testing.T) {
+	var gotOpts domain.FiscalOptions
+	var gotCalendarOpts domain.CalendarOptions
+	service := &MockFiscalService{
+		GetFiscalYearCalendarFunc: func(fiscalYear int, opts domain.FiscalOptions, calendarOpts domain.CalendarOptions) (*domain.FiscalYearCalendar, error) {
+			gotOpts, gotCalendarOpts = opts, calendarOpts
+			return &domain.FiscalYearCalendar{FiscalYear: fiscalYear, Months: []domain.Calendar{}}, nil
+		},
+	}
+	handler := NewFiscalHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/fiscal/2025?start_month=10&grid=true&week_start=mon", nil)
+	req = mux.SetURLVars(req, map[string]string{"year": "2025"})
+	w := httptest.NewRecorder()
+
+	handler.GetFiscalYearCalendar(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+	if gotOpts.StartMonth != 10 || !gotCalendarOpts.Grid || gotCalendarOpts.WeekStart != time.Monday {
+		t.Errorf("Unexpected options: %+v %+v", gotOpts, gotCalendarOpts)
+	}
+}
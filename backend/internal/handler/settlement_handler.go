@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// SettlementServiceInterface は締め日・支払日サービスのインターフェース
+type SettlementServiceInterface interface {
+	GetSettlementSchedule(year int, rule domain.SettlementRule, opts domain.BusinessDayOptions) (*domain.SettlementSchedule, error)
+}
+
+type SettlementHandler struct {
+	service SettlementServiceInterface
+}
+
+func NewSettlementHandler(service SettlementServiceInterface) *SettlementHandler {
+	return &SettlementHandler{service: service}
+}
+
+// GetSettlementSchedule 指定年の締め日・支払日一覧取得
+// closing_day・payment_month_offset・payment_day・shift で規則を指定し、
+// weekend・region・custom で休業日を指定する
+func (h *SettlementHandler) GetSettlementSchedule(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	year, err := strconv.Atoi(vars["year"])
+	if err != nil {
+		http.Error(w, "Invalid year", http.StatusBadRequest)
+		return
+	}
+
+	query := r.URL.Query()
+	rule := domain.SettlementRule{Shift: query.Get("shift")}
+	for name, dest := range map[string]*int{
+		"closing_day":          &rule.ClosingDay,
+		"payment_month_offset": &rule.PaymentMonthOffset,
+		"payment_day":          &rule.PaymentDay,
+	} {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+		if *dest, err = strconv.Atoi(value); err != nil {
+			http.Error(w, "Invalid "+name, http.StatusBadRequest)
+			return
+		}
+	}
+
+	opts, err := parseBusinessDayOptions(r)
+	if err != nil {
+		http.Error(w, "Invalid parameters", http.StatusBadRequest)
+		return
+	}
+
+	// 支払日を営業日に移動できない場合は 404
+	schedule, err := h.service.GetSettlementSchedule(year, rule, opts)
+	writeBusinessDayResponse(w, schedule, err)
+}
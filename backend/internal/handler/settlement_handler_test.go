@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// MockSettlementService はテスト用のモックサービス
+type MockSettlementService struct {
+	GetSettlementScheduleFunc func(year int, rule domain.SettlementRule, opts domain.BusinessDayOptions) (*domain.SettlementSchedule, error)
+}
+
+func (m *MockSettlementService) GetSettlementSchedule(year int, rule domain.SettlementRule, opts domain.BusinessDayOptions) (*domain.SettlementSchedule, error) {
+	if m.GetSettlementScheduleFunc != nil {
+		return m.GetSettlementScheduleFunc(year, rule, opts)
+	}
+	return &domain.SettlementSchedule{Year: year, Rule: rule, Entries: []domain.SettlementEntry{}}, nil
+}
+
+func TestSettlementHandler_GetSettlementSchedule_Success(t *testing.T) {
+	var got domain.SettlementRule
+	service := &MockSettlementService{
+		GetSettlementScheduleFunc: func(year int, rule domain.SettlementRule, opts domain.BusinessDayOptions) (*domain.SettlementSchedule, error) {
+			got = rule
+			return &domain.SettlementSchedule{Year: year, Rule: rule, Entries: []domain.SettlementEntry{}}, nil
+		},
+	}
+	handler := NewSettlementHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/settlements/2025?closing_day=0&payment_month_offset=1&payment_day=25&shift=previous", nil)
+	req = mux.SetURLVars(req, map[string]string{"year": "2025"})
+	w := httptest.NewRecorder()
+
+	handler.GetSettlementSchedule(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+
+	expected := domain.SettlementRule{ClosingDay: 0, PaymentMonthOffset: 1, PaymentDay: 25, Shift: domain.SettlementShiftPrevious}
+	if got != expected {
+		t.Errorf("Expected rule %+v, got %+v", expected, got)
+	}
+
+	var schedule domain.SettlementSchedule
+	if err := json.NewDecoder(w.Body).Decode(&schedule); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if schedule.Year != 2025 {
+		t.Errorf("Expected year 2025, got %d", schedule.Year)
+	}
+}
+
+func TestSettlementHandler_GetSettlementSchedule_InvalidParameters(t *testing.T) {
+	service := &MockSettlementService{
+		GetSettlementScheduleFunc: func(year int, rule domain.SettlementRule, opts domain.BusinessDayOptions) (*domain.SettlementSchedule, error) {
+			return nil, domain.ErrInvalidInput
+		},
+	}
+	handler := NewSettlementHandler(service)
+
+	tests := []string{
+		"/api/settlements/2025?closing_day=end",
+		"/api/settlements/2025?payment_day=25&weekend=someday",
+		"/api/settlements/2025?closing_day=20&payment_day=10",
+	}
+
+	for _, url := range tests {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		req = mux.SetURLVars(req, map[string]string{"year": "2025"})
+		w := httptest.NewRecorder()
+
+		handler.GetSettlementSchedule(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: Expected status code %d, got %d", url, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+func TestSettlementHandler_GetSettlementSchedule_NotFound(t *testing.T) {
+	service := &MockSettlementService{
+		GetSettlementScheduleFunc: func(year int, rule domain.SettlementRule, opts domain.BusinessDayOptions) (*domain.SettlementSchedule, error) {
+			return nil, domain.ErrNotFound
+		},
+	}
+	handler := NewSettlementHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/settlements/2025?payment_day=25&weekend=0,1,2,3,4,5,6", nil)
+	req = mux.SetURLVars(req, map[string]string{"year": "2025"})
+	w := httptest.NewRecorder()
+
+	handler.GetSettlementSchedule(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status code %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+type CustomHolidayRepository struct {
+	db *sql.DB
+}
+
+func NewCustomHolidayRepository(db *sql.DB) *CustomHolidayRepository {
+	return &CustomHolidayRepository{db: db}
+}
+
+// GetAll 全ての独自休日を取得
+func (r *CustomHolidayRepository) GetAll() ([]domain.CustomHoliday, error) {
+	query := `SELECT id, name, description, start_date, end_date, recurring, created_at, updated_at
+	          FROM custom_holidays ORDER BY start_date ASC, id ASC`
+
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	holidays := []domain.CustomHoliday{}
+	for rows.Next() {
+		var holiday domain.CustomHoliday
+		err := rows.Scan(
+			&holiday.ID,
+			&holiday.Name,
+			&holiday.Description,
+			&holiday.StartDate,
+			&holiday.EndDate,
+			&holiday.Recurring,
+			&holiday.CreatedAt,
+			&holiday.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		holidays = append(holidays, holiday)
+	}
+
+	return holidays, nil
+}
+
+// GetByDateRange 期間（start〜end）と重なる独自休日と、start 以前から毎年繰り返す独自休日を取得
+// 毎年繰り返す休日の各年への展開はサービス層で行う
+func (r *CustomHolidayRepository) GetByDateRange(start, end time.Time) ([]domain.CustomHoliday, error) {
+	query := `SELECT id, name, description, start_date, end_date, recurring, created_at, updated_at
+	          FROM custom_holidays
+	          WHERE start_date <= $2 AND (recurring OR end_date >= $1)
+	          ORDER BY start_date ASC, id ASC`
+
+	rows, err := r.db.Query(query, start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	holidays := []domain.CustomHoliday{}
+	for rows.Next() {
+		var holiday domain.CustomHoliday
+		err := rows.Scan(
+			&holiday.ID,
+			&holiday.Name,
+			&holiday.Description,
+			&holiday.StartDate,
+			&holiday.EndDate,
+			&holiday.Recurring,
+			&holiday.CreatedAt,
+			&holiday.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		holidays = append(holidays, holiday)
+	}
+
+	return holidays, nil
+}
+
+// GetByID IDで独自休日を取得
+func (r *CustomHolidayRepository) GetByID(id int) (*domain.CustomHoliday, error) {
+	query := `SELECT id, name, description, start_date, end_date, recurring, created_at, updated_at
+	          FROM custom_holidays WHERE id = $1`
+
+	var holiday domain.CustomHoliday
+	err := r.db.QueryRow(query, id).Scan(
+		&holiday.ID,
+		&holiday.Name,
+		&holiday.Description,
+		&holiday.StartDate,
+		&holiday.EndDate,
+		&holiday.Recurring,
+		&holiday.CreatedAt,
+		&holiday.UpdatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &holiday, nil
+}
+
+// Create 新しい独自休日を作成
+func (r *CustomHolidayRepository) Create(holiday *domain.CustomHoliday) error {
+	query := `INSERT INTO custom_holidays (name, description, start_date, end_date, recurring)
+	          VALUES ($1, $2, $3, $4, $5)
+	          RETURNING id, created_at, updated_at`
+
+	return r.db.QueryRow(
+		query,
+		holiday.Name,
+		holiday.Description,
+		holiday.StartDate,
+		holiday.EndDate,
+		holiday.Recurring,
+	).Scan(&holiday.ID, &holiday.CreatedAt, &holiday.UpdatedAt)
+}
+
+// Update 独自休日を更新
+func (r *CustomHolidayRepository) Update(holiday *domain.CustomHoliday) error {
+	query := `UPDATE custom_holidays
+	          SET name = $1, description = $2, start_date = $3, end_date = $4, recurring = $5
+	          WHERE id = $6
+	          RETURNING created_at, updated_at`
+
+	return r.db.QueryRow(
+		query,
+		holiday.Name,
+		holiday.Description,
+		holiday.StartDate,
+		holiday.EndDate,
+		holiday.Recurring,
+		holiday.ID,
+	).Scan(&holiday.CreatedAt, &holiday.UpdatedAt)
+}
+
+// Delete 独自休日を削除
+func (r *CustomHolidayRepository) Delete(id int) error {
+	query := `DELETE FROM custom_holidays WHERE id = $1`
+	_, err := r.db.Exec(query, id)
+	return err
+}
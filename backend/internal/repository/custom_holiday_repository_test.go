@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+func TestNewCustomHolidayRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	db := setupTestDB(t)
+	if db == nil {
+		return
+	}
+	defer db.Close()
+
+	repo := NewCustomHolidayRepository(db)
+	if repo == nil {
+		t.Error("NewCustomHolidayRepository should return a non-nil repository")
+	}
+}
+
+func TestCustomHolidayRepository_CRUD_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	db := setupTestDB(t)
+	if db == nil {
+		return
+	}
+	defer db.Close()
+
+	repo := NewCustomHolidayRepository(db)
+
+	holiday := &domain.CustomHoliday{
+		Name:      "年末年始休業",
+		StartDate: time.Date(2025, 12, 29, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC),
+		Recurring: true,
+	}
+
+	if err := repo.Create(holiday); err != nil {
+		t.Fatalf("Create should not return error: %v", err)
+	}
+	if holiday.ID == 0 {
+		t.Error("CustomHoliday ID should be set after creation")
+	}
+
+	holiday.Name = "冬季休業"
+	if err := repo.Update(holiday); err != nil {
+		t.Errorf("Update should not return error: %v", err)
+	}
+
+	retrieved, err := repo.GetByID(holiday.ID)
+	if err != nil {
+		t.Fatalf("GetByID should not return error: %v", err)
+	}
+	if retrieved == nil || retrieved.Name != "冬季休業" {
+		t.Errorf("Expected name '冬季休業', got %v", retrieved)
+	}
+
+	holidays, err := repo.GetAll()
+	if err != nil {
+		t.Errorf("GetAll should not return error: %v", err)
+	}
+	if len(holidays) == 0 {
+		t.Error("GetAll should return the created holiday")
+	}
+
+	if err := repo.Delete(holiday.ID); err != nil {
+		t.Errorf("Delete should not return error: %v", err)
+	}
+	deleted, err := repo.GetByID(holiday.ID)
+	if err != nil {
+		t.Errorf("GetByID after delete should not return error: %v", err)
+	}
+	if deleted != nil {
+		t.Error("CustomHoliday should be deleted")
+	}
+}
@@ -2,6 +2,10 @@ package repository
 
 import (
 	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
@@ -15,12 +19,40 @@ func NewEventRepository(db *sql.DB) *EventRepository {
 	return &EventRepository{db: db}
 }
 
-// GetAll 全てのイベントを取得
-func (r *EventRepository) GetAll() ([]domain.Event, error) {
-	query := `SELECT id, title, description, start_date, end_date, all_day, created_at, updated_at 
-	          FROM events ORDER BY start_date ASC`
+// GetByID IDでイベントを取得
+func (r *EventRepository) GetByID(id int) (*domain.Event, error) {
+	query := `SELECT id, title, description, start_date, end_date, all_day, time_zone,
+	          rrule, array_to_json(exdates), array_to_json(rdates), recurring_event_id, recurrence_id, business_rule, created_at, updated_at
+	          FROM events WHERE id = $1`
+
+	event, err := scanEvent(r.db.QueryRow(query, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+// GetByDateRange 期間内のイベントを取得
+// 繰り返しイベントは期間より前に始まるシリーズも含めて取得し、各回への展開はサービス層で行う。
+// 休業日の回を前営業日に移すシリーズは、期間の後に始まっても移した回が期間に入りうるため、移しうる最大の日数だけ後まで取得する。
+// RDATE で追加した回は、最初の回と同じ長さで期間と重なるものがある場合のみ取得する
+func (r *EventRepository) GetByDateRange(start, end time.Time) ([]domain.Event, error) {
+	query := `SELECT id, title, description, start_date, end_date, all_day, time_zone,
+	          rrule, array_to_json(exdates), array_to_json(rdates), recurring_event_id, recurrence_id, business_rule, created_at, updated_at
+	          FROM events 
+	          WHERE (start_date <= $2 AND end_date >= $1)
+	             OR (rrule <> '' AND start_date <= $2)
+	             OR (rrule <> '' AND business_rule->>'shift' = $3 AND start_date <= $2 + make_interval(days => $4))
+	             OR (start_date <= $2 AND EXISTS (
+	                 SELECT 1 FROM unnest(rdates) AS rdate
+	                 WHERE rdate <= $2 AND rdate + (end_date - start_date) >= $1))
+	          ORDER BY start_date ASC`
 
-	rows, err := r.db.Query(query)
+	rows, err := r.db.Query(query, start, end, domain.RecurrenceShiftPrevious, domain.MaxBusinessDaySearch)
 	if err != nil {
 		return nil, err
 	}
@@ -28,17 +60,7 @@ func (r *EventRepository) GetAll() ([]domain.Event, error) {
 
 	var events []domain.Event
 	for rows.Next() {
-		var event domain.Event
-		err := rows.Scan(
-			&event.ID,
-			&event.Title,
-			&event.Description,
-			&event.StartDate,
-			&event.EndDate,
-			&event.AllDay,
-			&event.CreatedAt,
-			&event.UpdatedAt,
-		)
+		event, err := scanEvent(rows)
 		if err != nil {
 			return nil, err
 		}
@@ -48,98 +70,211 @@ func (r *EventRepository) GetAll() ([]domain.Event, error) {
 	return events, nil
 }
 
-// GetByID IDでイベントを取得
-func (r *EventRepository) GetByID(id int) (*domain.Event, error) {
-	query := `SELECT id, title, description, start_date, end_date, all_day, created_at, updated_at 
-	          FROM events WHERE id = $1`
+// eventSortColumns 一覧の並び替えの項目と、カーソルの値の型
+var eventSortColumns = map[string]string{
+	domain.EventSortStartDate: "timestamptz",
+	domain.EventSortUpdatedAt: "timestamptz",
+	domain.EventSortTitle:     "text",
+}
 
-	var event domain.Event
-	err := r.db.QueryRow(query, id).Scan(
-		&event.ID,
-		&event.Title,
-		&event.Description,
-		&event.StartDate,
-		&event.EndDate,
-		&event.AllDay,
-		&event.CreatedAt,
-		&event.UpdatedAt,
-	)
+// List 絞り込んだイベントを並び替えの項目とIDの順に opts.Limit 件まで取得
+// after を指定した場合はそのイベントの後から取得する（キーセットページネーション）
+func (r *EventRepository) List(opts domain.EventListOptions, after *domain.EventCursor) ([]domain.Event, error) {
+	valueType, ok := eventSortColumns[opts.Sort]
+	if !ok {
+		return nil, domain.ErrInvalidInput
+	}
 
-	if err == sql.ErrNoRows {
-		return nil, nil
+	conditions := []string{}
+	args := []interface{}{}
+	addArg := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if opts.AllDay != nil {
+		conditions = append(conditions, "all_day = "+addArg(*opts.AllDay))
+	}
+	if opts.UpdatedSince != nil {
+		conditions = append(conditions, "updated_at >= "+addArg(*opts.UpdatedSince))
+	}
+	if opts.TitlePrefix != "" {
+		conditions = append(conditions, "title LIKE "+addArg(escapeLike(opts.TitlePrefix)+"%"))
+	}
+	direction, comparison := "ASC", ">"
+	if opts.Desc {
+		direction, comparison = "DESC", "<"
 	}
+	if after != nil {
+		conditions = append(conditions, fmt.Sprintf("(%s, id) %s (%s::%s, %s)",
+			opts.Sort, comparison, addArg(after.Value), valueType, addArg(after.ID)))
+	}
+
+	query := `SELECT id, title, description, start_date, end_date, all_day, time_zone,
+	          rrule, array_to_json(exdates), array_to_json(rdates), recurring_event_id, recurrence_id, business_rule, created_at, updated_at
+	          FROM events`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += fmt.Sprintf(" ORDER BY %s %s, id %s LIMIT %s", opts.Sort, direction, direction, addArg(opts.Limit))
+
+	rows, err := r.db.Query(query, args...)
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
-	return &event, nil
+	events := []domain.Event{}
+	for rows.Next() {
+		event, err := scanEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
 }
 
-// GetByDateRange 期間内のイベントを取得
-func (r *EventRepository) GetByDateRange(start, end time.Time) ([]domain.Event, error) {
-	query := `SELECT id, title, description, start_date, end_date, all_day, created_at, updated_at 
-	          FROM events 
-	          WHERE start_date <= $2 AND end_date >= $1
-	          ORDER BY start_date ASC`
+// Search タイトルか説明にすべての検索語（正規化済み）を含むイベントを関連度の高い順に limit 件まで取得
+// 関連度はタイトルの前方一致とトライグラムの類似度で決め、同じ関連度の場合は開始日時が now に近い順とする
+func (r *EventRepository) Search(terms []string, limit int, now time.Time) ([]domain.EventSearchResult, error) {
+	query := strings.Join(terms, " ")
+	args := []interface{}{query, escapeLike(query) + "%", now, limit}
+
+	conditions := []string{}
+	for _, term := range terms {
+		args = append(args, "%"+escapeLike(term)+"%")
+		conditions = append(conditions, fmt.Sprintf("(search_title LIKE $%d OR search_description LIKE $%d)", len(args), len(args)))
+	}
 
-	rows, err := r.db.Query(query, start, end)
+	rows, err := r.db.Query(`SELECT id, title, description, start_date, end_date, all_day, time_zone,
+	          rrule, array_to_json(exdates), array_to_json(rdates), recurring_event_id, recurrence_id, business_rule, created_at, updated_at,
+	          score
+	          FROM (
+	              SELECT *,
+	                     CASE WHEN search_title LIKE $2 THEN 1 ELSE 0 END
+	                     + 2 * similarity(search_title, $1)
+	                     + word_similarity($1, search_title)
+	                     + word_similarity($1, search_description) AS score
+	              FROM events
+	              WHERE `+strings.Join(conditions, " AND ")+`
+	          ) AS matched
+	          ORDER BY score DESC, ABS(EXTRACT(EPOCH FROM start_date - $3::timestamptz)) ASC, id ASC
+	          LIMIT $4`, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var events []domain.Event
+	results := []domain.EventSearchResult{}
 	for rows.Next() {
-		var event domain.Event
-		err := rows.Scan(
-			&event.ID,
-			&event.Title,
-			&event.Description,
-			&event.StartDate,
-			&event.EndDate,
-			&event.AllDay,
-			&event.CreatedAt,
-			&event.UpdatedAt,
-		)
+		var score float64
+		event, err := scanEvent(scoreScanner{rows, &score})
 		if err != nil {
 			return nil, err
 		}
-		events = append(events, event)
+		results = append(results, domain.EventSearchResult{Event: event, Score: score})
 	}
 
-	return events, nil
+	return results, rows.Err()
+}
+
+// scoreScanner イベントの列に続く関連度の列を読み込む
+type scoreScanner struct {
+	row   rowScanner
+	score *float64
+}
+
+func (s scoreScanner) Scan(dest ...interface{}) error {
+	return s.row.Scan(append(dest, s.score)...)
+}
+
+// escapeLike LIKE のパターンで特別な意味を持つ文字をエスケープ
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
 }
 
 // Create 新しいイベントを作成
 func (r *EventRepository) Create(event *domain.Event) error {
-	query := `INSERT INTO events (title, description, start_date, end_date, all_day) 
-	          VALUES ($1, $2, $3, $4, $5) 
+	return insertEvent(r.db, event)
+}
+
+// Update イベントを更新
+func (r *EventRepository) Update(event *domain.Event) error {
+	return updateEvent(r.db, event)
+}
+
+// UpdateSeries 繰り返しイベントのシリーズの更新と、シリーズから分けたイベントの作成を1つのトランザクションで行う
+// 「この回のみ」「この回以降」の変更で、分けた回だけが作られてシリーズに残ることがないようにする
+func (r *EventRepository) UpdateSeries(series, created *domain.Event) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := insertEvent(tx, created); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := updateEvent(tx, series); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// queryRower *sql.DB と *sql.Tx に共通の QueryRow
+type queryRower interface {
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+// insertEvent イベントを追加し、採番したIDと作成日時を設定する
+func insertEvent(q queryRower, event *domain.Event) error {
+	query := `INSERT INTO events (title, description, start_date, end_date, all_day, time_zone,
+	                              rrule, exdates, rdates, recurring_event_id, recurrence_id, business_rule) 
+	          VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) 
 	          RETURNING id, created_at, updated_at`
 
-	return r.db.QueryRow(
+	return q.QueryRow(
 		query,
 		event.Title,
 		event.Description,
 		event.StartDate,
 		event.EndDate,
 		event.AllDay,
+		event.TimeZone,
+		event.RRule,
+		timeArray(event.ExDates),
+		timeArray(event.RDates),
+		event.RecurringEventID,
+		event.RecurrenceID,
+		businessRule{event.BusinessRule},
 	).Scan(&event.ID, &event.CreatedAt, &event.UpdatedAt)
 }
 
-// Update イベントを更新
-func (r *EventRepository) Update(event *domain.Event) error {
+// updateEvent イベントを更新し、更新日時を設定する
+func updateEvent(q queryRower, event *domain.Event) error {
 	query := `UPDATE events 
-	          SET title = $1, description = $2, start_date = $3, end_date = $4, all_day = $5
-	          WHERE id = $6
+	          SET title = $1, description = $2, start_date = $3, end_date = $4, all_day = $5, time_zone = $6,
+	              rrule = $7, exdates = $8, rdates = $9, recurring_event_id = $10, recurrence_id = $11,
+	              business_rule = $12
+	          WHERE id = $13
 	          RETURNING updated_at`
 
-	return r.db.QueryRow(
+	return q.QueryRow(
 		query,
 		event.Title,
 		event.Description,
 		event.StartDate,
 		event.EndDate,
 		event.AllDay,
+		event.TimeZone,
+		event.RRule,
+		timeArray(event.ExDates),
+		timeArray(event.RDates),
+		event.RecurringEventID,
+		event.RecurrenceID,
+		businessRule{event.BusinessRule},
 		event.ID,
 	).Scan(&event.UpdatedAt)
 }
@@ -150,3 +285,130 @@ func (r *EventRepository) Delete(id int) error {
 	_, err := r.db.Exec(query, id)
 	return err
 }
+
+// rowScanner *sql.Row と *sql.Rows に共通の Scan
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanEvent イベントの列を読み込む
+func scanEvent(row rowScanner) (domain.Event, error) {
+	var event domain.Event
+	var exdates, rdates timeArray
+	var recurringEventID sql.NullInt64
+	var recurrenceID sql.NullTime
+	var rule businessRule
+	err := row.Scan(
+		&event.ID,
+		&event.Title,
+		&event.Description,
+		&event.StartDate,
+		&event.EndDate,
+		&event.AllDay,
+		&event.TimeZone,
+		&event.RRule,
+		&exdates,
+		&rdates,
+		&recurringEventID,
+		&recurrenceID,
+		&rule,
+		&event.CreatedAt,
+		&event.UpdatedAt,
+	)
+	if err != nil {
+		return event, err
+	}
+
+	if len(exdates) > 0 {
+		event.ExDates = exdates
+	}
+	if len(rdates) > 0 {
+		event.RDates = rdates
+	}
+	if recurringEventID.Valid {
+		id := int(recurringEventID.Int64)
+		event.RecurringEventID = &id
+	}
+	if recurrenceID.Valid {
+		t := recurrenceID.Time
+		event.RecurrenceID = &t
+	}
+	event.BusinessRule = rule.rule
+	return event, nil
+}
+
+// timeArray TIMESTAMPTZ[] の列の値
+// 書き込みは配列リテラル、読み込みは array_to_json で取り出したJSONを使う
+type timeArray []time.Time
+
+// Value 配列リテラル（例: {"2025-01-06T09:00:00Z"}）に変換
+func (a timeArray) Value() (driver.Value, error) {
+	values := make([]string, 0, len(a))
+	for _, t := range a {
+		values = append(values, `"`+t.UTC().Format(time.RFC3339Nano)+`"`)
+	}
+	return "{" + strings.Join(values, ",") + "}", nil
+}
+
+// Scan array_to_json の結果（NULL は空の配列）を読み込む
+func (a *timeArray) Scan(src interface{}) error {
+	var data []byte
+	switch v := src.(type) {
+	case nil:
+		*a = timeArray{}
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("unsupported type for timeArray: %T", src)
+	}
+
+	var times []time.Time
+	if err := json.Unmarshal(data, &times); err != nil {
+		return err
+	}
+	*a = times
+	return nil
+}
+
+// businessRule JSONB の business_rule 列の値（NULL は営業日に基づく繰り返しなし）
+type businessRule struct {
+	rule *domain.EventBusinessRule
+}
+
+// Value JSONに変換
+func (b businessRule) Value() (driver.Value, error) {
+	if b.rule == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(b.rule)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan JSONを読み込む
+func (b *businessRule) Scan(src interface{}) error {
+	var data []byte
+	switch v := src.(type) {
+	case nil:
+		b.rule = nil
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("unsupported type for businessRule: %T", src)
+	}
+
+	var rule domain.EventBusinessRule
+	if err := json.Unmarshal(data, &rule); err != nil {
+		return err
+	}
+	b.rule = &rule
+	return nil
+}
@@ -115,7 +115,7 @@ func TestEventRepository_GetByID_Integration(t *testing.T) {
 	}
 }
 
-func TestEventRepository_GetAll_Integration(t *testing.T) {
+func TestEventRepository_List_Integration(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
 	}
@@ -128,13 +128,13 @@ func TestEventRepository_GetAll_Integration(t *testing.T) {
 
 	repo := NewEventRepository(db)
 
-	events, err := repo.GetAll()
+	events, err := repo.List(domain.EventListOptions{Sort: domain.EventSortStartDate, Limit: 10}, nil)
 	if err != nil {
-		t.Errorf("GetAll should not return error: %v", err)
+		t.Errorf("List should not return error: %v", err)
 	}
 
 	if events == nil {
-		t.Error("GetAll should return a non-nil slice")
+		t.Error("List should return a non-nil slice")
 	}
 }
 
@@ -276,3 +276,73 @@ func TestEventRepository_GetByDateRange_Integration(t *testing.T) {
 		t.Errorf("Expected at least 2 events in range, got %d", len(events))
 	}
 }
+
+func TestTimeArray_ValueAndScan(t *testing.T) {
+	times := timeArray{
+		time.Date(2025, 1, 13, 1, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 20, 10, 0, 0, 0, time.FixedZone("JST", 9*60*60)),
+	}
+
+	value, err := times.Value()
+	if err != nil {
+		t.Fatalf("Value should not return error: %v", err)
+	}
+	if value != `{"2025-01-13T01:00:00Z","2025-01-20T01:00:00Z"}` {
+		t.Errorf("Unexpected array literal: %v", value)
+	}
+
+	// array_to_json が返す形式
+	var scanned timeArray
+	if err := scanned.Scan([]byte(`["2025-01-13T01:00:00+00:00","2025-01-20T10:00:00+09:00"]`)); err != nil {
+		t.Fatalf("Scan should not return error: %v", err)
+	}
+	if len(scanned) != 2 || !scanned[0].Equal(times[0]) || !scanned[1].Equal(times[1]) {
+		t.Errorf("Unexpected scanned times: %v", scanned)
+	}
+
+	if err := scanned.Scan(nil); err != nil || len(scanned) != 0 {
+		t.Errorf("Expected NULL to scan as an empty array, got %v (%v)", scanned, err)
+	}
+}
+
+func TestBusinessRule_ValueAndScan(t *testing.T) {
+	value, err := businessRule{}.Value()
+	if err != nil || value != nil {
+		t.Errorf("Expected nil rule to be NULL, got %v (%v)", value, err)
+	}
+
+	rule := businessRule{&domain.EventBusinessRule{BusinessDay: -1, Shift: "previous"}}
+	value, err = rule.Value()
+	if err != nil {
+		t.Fatalf("Value should not return error: %v", err)
+	}
+	if value != `{"business_day":-1,"shift":"previous"}` {
+		t.Errorf("Unexpected JSON: %v", value)
+	}
+
+	var scanned businessRule
+	if err := scanned.Scan([]byte(`{"business_day":3,"weekend":[0,6],"include_custom":true}`)); err != nil {
+		t.Fatalf("Scan should not return error: %v", err)
+	}
+	if scanned.rule == nil || scanned.rule.BusinessDay != 3 || len(scanned.rule.Weekend) != 2 || !scanned.rule.IncludeCustom {
+		t.Errorf("Unexpected scanned rule: %+v", scanned.rule)
+	}
+
+	if err := scanned.Scan(nil); err != nil || scanned.rule != nil {
+		t.Errorf("Expected NULL to scan as nil, got %+v (%v)", scanned.rule, err)
+	}
+}
+
+func TestEscapeLike(t *testing.T) {
+	tests := map[string]string{
+		"定例会":    "定例会",
+		"100%":   `100\%`,
+		"a_b":    `a\_b`,
+		`C:\tmp`: `C:\\tmp`,
+	}
+	for value, expected := range tests {
+		if got := escapeLike(value); got != expected {
+			t.Errorf("%q: Expected %q, got %q", value, expected, got)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"math"
+	"time"
+)
+
+// jst 日本標準時
+var jst = time.FixedZone("JST", 9*60*60)
+
+const (
+	// j2000 J2000.0 のユリウス日
+	j2000 = 2451545.0
+	// unixEpochJD 1970-01-01T00:00:00Z のユリウス日
+	unixEpochJD = 2440587.5
+	// secondsPerDay 1日の秒数
+	secondsPerDay = 86400
+	// tropicalYear 太陽年（日）
+	tropicalYear = 365.242189
+)
+
+// julianDay 時刻をユリウス日（UT）に変換
+// UnixNano は1678〜2262年の外であふれるため、秒と秒未満に分けて計算する
+func julianDay(t time.Time) float64 {
+	return float64(t.Unix())/secondsPerDay + float64(t.Nanosecond())/float64(24*time.Hour) + unixEpochJD
+}
+
+// timeFromJulianDay ユリウス日（UT）を時刻に変換
+func timeFromJulianDay(jd float64) time.Time {
+	seconds := (jd - unixEpochJD) * secondsPerDay
+	whole := math.Floor(seconds)
+	return time.Unix(int64(whole), int64(math.Round((seconds-whole)*float64(time.Second)))).UTC()
+}
+
+// normalizeAngle 角度を0～360度に正規化
+func normalizeAngle(deg float64) float64 {
+	deg = math.Mod(deg, 360)
+	if deg < 0 {
+		deg += 360
+	}
+	return deg
+}
+
+// sinDeg 度単位の正弦
+func sinDeg(deg float64) float64 {
+	return math.Sin(deg * math.Pi / 180)
+}
+
+// cosDeg 度単位の余弦
+func cosDeg(deg float64) float64 {
+	return math.Cos(deg * math.Pi / 180)
+}
+
+// deltaT 地球時と世界時の差 ΔT（秒）を求める（Espenak & Meeus の多項式近似）
+func deltaT(year float64) float64 {
+	longTerm := func(y float64) float64 {
+		u := (y - 1820) / 100
+		return -20 + 32*u*u
+	}
+
+	switch {
+	case year < 1620:
+		return longTerm(year)
+	case year < 1700:
+		t := year - 1600
+		return 120 - 0.9808*t - 0.01532*t*t + t*t*t/7129
+	case year < 1800:
+		t := year - 1700
+		return 8.83 + 0.1603*t - 0.0059285*t*t + 0.00013336*t*t*t - math.Pow(t, 4)/1174000
+	case year < 1860:
+		t := year - 1800
+		return 13.72 - 0.332447*t + 0.0068612*t*t + 0.0041116*t*t*t - 0.00037436*math.Pow(t, 4) +
+			0.0000121272*math.Pow(t, 5) - 0.0000001699*math.Pow(t, 6) + 0.000000000875*math.Pow(t, 7)
+	case year < 1900:
+		t := year - 1860
+		return 7.62 + 0.5737*t - 0.251754*t*t + 0.01680668*t*t*t - 0.0004473624*math.Pow(t, 4) + math.Pow(t, 5)/233174
+	case year < 1920:
+		t := year - 1900
+		return -2.79 + 1.494119*t - 0.0598939*t*t + 0.0061966*t*t*t - 0.000197*math.Pow(t, 4)
+	case year < 1941:
+		t := year - 1920
+		return 21.20 + 0.84493*t - 0.076100*t*t + 0.0020936*t*t*t
+	case year < 1961:
+		t := year - 1950
+		return 29.07 + 0.407*t - t*t/233 + t*t*t/2547
+	case year < 1986:
+		t := year - 1975
+		return 45.45 + 1.067*t - t*t/260 - t*t*t/718
+	case year < 2005:
+		t := year - 2000
+		return 63.86 + 0.3345*t - 0.060374*t*t + 0.0017275*t*t*t + 0.000651814*math.Pow(t, 4) + 0.00002373599*math.Pow(t, 5)
+	case year < 2050:
+		t := year - 2000
+		return 62.92 + 0.32217*t + 0.005589*t*t
+	case year < 2150:
+		return longTerm(year) - 0.5628*(2150-year)
+	default:
+		return longTerm(year)
+	}
+}
+
+// terrestrialJulianDay ユリウス日（UT）を力学時のユリウス日に変換
+func terrestrialJulianDay(jd float64) float64 {
+	year := 2000 + (jd-j2000)/tropicalYear
+	return jd + deltaT(year)/86400
+}
+
+// solarPerturbations 太陽黄経の周期摂動項（振幅は1e-5日単位、位相・角速度は度）
+// Meeus『Astronomical Algorithms』第27章の分至点補正項のうち章動以外を黄経へ換算して用いる
+var solarPerturbations = []struct {
+	amplitude, phase, rate float64
+}{
+	{203, 337.23, 32964.467},
+	{199, 342.08, 20.186},
+	{182, 27.85, 445267.112},
+	{156, 73.14, 45036.886},
+	{136, 171.52, 22518.443},
+	{77, 222.54, 65928.934},
+	{74, 296.72, 3034.906},
+	{70, 243.58, 9037.513},
+	{58, 119.81, 33718.147},
+	{52, 297.17, 150.678},
+	{50, 21.02, 2281.226},
+	{45, 247.54, 29929.562},
+	{44, 325.15, 31555.956},
+	{29, 60.93, 4443.417},
+	{18, 155.12, 67555.328},
+	{17, 288.79, 4562.452},
+	{16, 198.04, 62894.029},
+	{14, 199.76, 31436.921},
+	{12, 95.39, 14577.848},
+	{12, 287.11, 31931.756},
+	{12, 320.81, 34777.259},
+	{9, 227.73, 1222.114},
+	{8, 15.45, 16859.074},
+}
+
+// sunLongitude 指定時刻（ユリウス日, UT）における太陽の視黄経（度）を計算
+// Meeus 第25章の低精度式に摂動項を加えたもので、分至点の時刻で1分程度の精度
+func sunLongitude(jd float64) float64 {
+	t := (terrestrialJulianDay(jd) - j2000) / 36525
+
+	// 平均黄経と平均近点角
+	l0 := 280.46646 + 36000.76983*t + 0.0003032*t*t
+	m := 357.52911 + 35999.05029*t - 0.0001537*t*t
+
+	// 中心差
+	c := (1.914602-0.004817*t-0.000014*t*t)*sinDeg(m) +
+		(0.019993-0.000101*t)*sinDeg(2*m) +
+		0.000289*sinDeg(3*m)
+
+	// 惑星・月による摂動（1日あたりの平均運動で時間から角度へ換算）
+	perturbation := 0.0
+	for _, p := range solarPerturbations {
+		perturbation -= p.amplitude * 1e-5 * (360 / tropicalYear) * cosDeg(p.phase+p.rate*t)
+	}
+
+	// 章動と光行差
+	omega := 125.04 - 1934.136*t
+	return normalizeAngle(l0 + c + perturbation - 0.00569 - 0.00478*sinDeg(omega))
+}
+
+// solarTermTime 太陽黄経が指定角度となる時刻を、近似時刻 guess からニュートン法で求める
+func solarTermTime(longitude float64, guess time.Time) time.Time {
+	jd := julianDay(guess)
+	for i := 0; i < 20; i++ {
+		diff := normalizeAngle(sunLongitude(jd)-longitude+180) - 180
+		jd -= diff / 360 * tropicalYear
+		if math.Abs(diff) < 1e-7 {
+			break
+		}
+	}
+	return timeFromJulianDay(jd)
+}
+
+// dateInJST 時刻を日本時間の日付（UTCの0時として表現）に変換
+func dateInJST(t time.Time) time.Time {
+	return dateIn(t, jst)
+}
+
+// dateIn 時刻を指定タイムゾーンの日付（UTCの0時として表現）に変換
+func dateIn(t time.Time, loc *time.Location) time.Time {
+	local := t.In(loc)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// jstMidnight 日付（UTCの0時として表現）を日本時間の0時の時刻に変換
+func jstMidnight(date time.Time) time.Time {
+	return midnightIn(date, jst)
+}
+
+// midnightIn 日付（UTCの0時として表現）を指定タイムゾーンの0時の時刻に変換
+func midnightIn(date time.Time, loc *time.Location) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc)
+}
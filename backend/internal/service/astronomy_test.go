@@ -0,0 +1,139 @@
+package service
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+func TestCalendarService_CalculateEquinox_NAOJ(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+
+	// 国立天文台 暦要項（および暦計算室の予測値）による春分日・秋分日
+	tests := []struct {
+		year    int
+		shunbun int
+		shubun  int
+	}{
+		{1948, 21, 23},
+		{1949, 21, 23},
+		{1979, 21, 24},
+		{2012, 20, 22},
+		{2016, 20, 22},
+		{2019, 21, 23},
+		{2023, 21, 23},
+		{2024, 20, 22},
+		{2025, 20, 23},
+		{2026, 20, 23},
+		{2027, 21, 23},
+		{2044, 20, 22},
+		{2092, 19, 22},
+	}
+
+	for _, test := range tests {
+		shunbun := service.calculateShunbun(test.year)
+		expected := time.Date(test.year, time.March, test.shunbun, 0, 0, 0, 0, time.UTC)
+		if !shunbun.Equal(expected) {
+			t.Errorf("%d 春分の日: expected %v, got %v", test.year, expected.Format("2006-01-02"), shunbun.Format("2006-01-02"))
+		}
+
+		shubun := service.calculateShubun(test.year)
+		expected = time.Date(test.year, time.September, test.shubun, 0, 0, 0, 0, time.UTC)
+		if !shubun.Equal(expected) {
+			t.Errorf("%d 秋分の日: expected %v, got %v", test.year, expected.Format("2006-01-02"), shubun.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestSolarTermTime(t *testing.T) {
+	// 国立天文台による2025年の二分二至・立春の時刻（日本時間）
+	tests := []struct {
+		name      string
+		longitude float64
+		expected  time.Time
+	}{
+		{"立春", 315, time.Date(2025, 2, 3, 23, 10, 0, 0, jst)},
+		{"春分", 0, time.Date(2025, 3, 20, 18, 1, 0, 0, jst)},
+		{"夏至", 90, time.Date(2025, 6, 21, 11, 42, 0, 0, jst)},
+		{"秋分", 180, time.Date(2025, 9, 23, 3, 19, 0, 0, jst)},
+		{"冬至", 270, time.Date(2025, 12, 22, 0, 3, 0, 0, jst)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := solarTermTime(test.longitude, test.expected.AddDate(0, 0, -3))
+			if diff := math.Abs(got.Sub(test.expected).Minutes()); diff > 2 {
+				t.Errorf("Expected %v, got %v (diff %.1f min)", test.expected, got.In(jst), diff)
+			}
+		})
+	}
+}
+
+func TestSunLongitude_Range(t *testing.T) {
+	start := julianDay(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	for i := 0; i < 365; i++ {
+		lon := sunLongitude(start + float64(i))
+		if lon < 0 || lon >= 360 {
+			t.Fatalf("Longitude out of range: %f", lon)
+		}
+	}
+}
+
+func TestJulianDay_RoundTrip(t *testing.T) {
+	original := time.Date(2025, 3, 20, 9, 1, 30, 0, time.UTC)
+
+	if jd := julianDay(time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC)); jd != j2000 {
+		t.Errorf("Expected J2000.0 = %f, got %f", j2000, jd)
+	}
+
+	got := timeFromJulianDay(julianDay(original))
+	if diff := got.Sub(original); diff > time.Millisecond || diff < -time.Millisecond {
+		t.Errorf("Expected %v, got %v", original, got)
+	}
+}
+
+func TestJulianDay_OutsideUnixNanoRange(t *testing.T) {
+	// UnixNano で表せない1678年以前・2262年以降でも往復して元の時刻に戻ること
+	for _, original := range []time.Time{
+		time.Date(1600, 3, 20, 6, 30, 0, 0, time.UTC),
+		time.Date(2300, 3, 20, 18, 15, 0, 0, time.UTC),
+		time.Date(3000, 12, 21, 0, 0, 0, 0, time.UTC),
+	} {
+		got := timeFromJulianDay(julianDay(original))
+		if diff := got.Sub(original); diff > time.Millisecond || diff < -time.Millisecond {
+			t.Errorf("Expected %v, got %v", original, got)
+		}
+	}
+
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+	if shunbun := service.calculateShunbun(2300); shunbun.Year() != 2300 || shunbun.Month() != time.March {
+		t.Errorf("2300 春分の日 should be in March 2300, got %v", shunbun.Format("2006-01-02"))
+	}
+}
+
+func TestCalendarService_GetHolidays_Method(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+	holidays := service.GetHolidays(2025)
+
+	expected := map[string]string{
+		"元日":   domain.HolidayMethodFixed,
+		"成人の日": domain.HolidayMethodHappyMonday,
+		"春分の日": domain.HolidayMethodAstronomical,
+		"秋分の日": domain.HolidayMethodAstronomical,
+		"振替休日": domain.HolidayMethodSubstitute,
+	}
+
+	for _, h := range holidays {
+		if method, ok := expected[h.Name]; ok && h.Method != method {
+			t.Errorf("%s: expected method '%s', got '%s'", h.Name, method, h.Method)
+		}
+	}
+
+	for _, h := range service.GetHolidays(2020) {
+		if h.Name == "海の日" && h.Method != domain.HolidayMethodSpecial {
+			t.Errorf("2020 海の日 should be reported as special, got '%s'", h.Method)
+		}
+	}
+}
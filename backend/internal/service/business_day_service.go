@@ -0,0 +1,234 @@
+package service
+
+import (
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// defaultWeekend 既定の休業曜日
+var defaultWeekend = []time.Weekday{time.Saturday, time.Sunday}
+
+type BusinessDayService struct {
+	calendar HolidayCalendarInterface
+}
+
+// HolidayCalendarInterface 営業日計算に必要な祝日・休日の取得元
+type HolidayCalendarInterface interface {
+	GetHolidaysForRegions(year int, regions []string) ([]domain.Holiday, error)
+	GetCustomHolidays(start, end time.Time) ([]domain.Holiday, error)
+}
+
+func NewBusinessDayService(calendar HolidayCalendarInterface) *BusinessDayService {
+	return &BusinessDayService{calendar: calendar}
+}
+
+// CheckBusinessDay 指定日が営業日かどうかを判定
+func (s *BusinessDayService) CheckBusinessDay(date time.Time, opts domain.BusinessDayOptions) (*domain.BusinessDay, error) {
+	bc, err := s.newBusinessCalendar(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	date = truncateToDate(date)
+	holidays, err := bc.holidaysOn(date)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.BusinessDay{
+		Date:          date,
+		IsBusinessDay: !bc.weekend[date.Weekday()] && len(holidays) == 0,
+		IsWeekend:     bc.weekend[date.Weekday()],
+		Holidays:      holidays,
+	}, nil
+}
+
+// AddBusinessDays 指定日からN営業日後の日付を求める（n が負の場合はN営業日前）
+// 起算日は数えず、n が 0 の場合は起算日をそのまま返す
+func (s *BusinessDayService) AddBusinessDays(date time.Time, n int, opts domain.BusinessDayOptions) (*domain.BusinessDayShift, error) {
+	if n > domain.MaxBusinessDaySearch || n < -domain.MaxBusinessDaySearch {
+		return nil, domain.ErrInvalidInput
+	}
+	bc, err := s.newBusinessCalendar(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	from := truncateToDate(date)
+	step, remaining := 1, n
+	if n < 0 {
+		step, remaining = -1, -n
+	}
+
+	d := from
+	for remaining > 0 {
+		d = d.AddDate(0, 0, step)
+		ok, err := bc.isBusinessDay(d)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			remaining--
+		}
+	}
+
+	return &domain.BusinessDayShift{From: from, Days: n, Date: d}, nil
+}
+
+// NextBusinessDay 指定日より後で最も近い営業日
+func (s *BusinessDayService) NextBusinessDay(date time.Time, opts domain.BusinessDayOptions) (*domain.BusinessDay, error) {
+	return s.nearestBusinessDay(date, 1, opts)
+}
+
+// PreviousBusinessDay 指定日より前で最も近い営業日
+func (s *BusinessDayService) PreviousBusinessDay(date time.Time, opts domain.BusinessDayOptions) (*domain.BusinessDay, error) {
+	return s.nearestBusinessDay(date, -1, opts)
+}
+
+// CountBusinessDays 開始日から終了日まで（両端を含む）の営業日数を数える
+func (s *BusinessDayService) CountBusinessDays(start, end time.Time, opts domain.BusinessDayOptions) (*domain.BusinessDayCount, error) {
+	start = truncateToDate(start)
+	end = truncateToDate(end)
+	if end.Before(start) {
+		return nil, domain.ErrInvalidInput
+	}
+
+	calendarDays := int(end.Sub(start).Hours()/24) + 1
+	if calendarDays > domain.MaxBusinessDaySearch {
+		return nil, domain.ErrInvalidInput
+	}
+
+	bc, err := s.newBusinessCalendar(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	count := 0
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		ok, err := bc.isBusinessDay(d)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			count++
+		}
+	}
+
+	return &domain.BusinessDayCount{
+		Start:        start,
+		End:          end,
+		BusinessDays: count,
+		CalendarDays: calendarDays,
+	}, nil
+}
+
+// nearestBusinessDay step の方向に指定日を含まずに最も近い営業日を探す
+func (s *BusinessDayService) nearestBusinessDay(date time.Time, step int, opts domain.BusinessDayOptions) (*domain.BusinessDay, error) {
+	bc, err := s.newBusinessCalendar(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	d := truncateToDate(date)
+	for i := 0; i < domain.MaxBusinessDaySearch; i++ {
+		d = d.AddDate(0, 0, step)
+		ok, err := bc.isBusinessDay(d)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return &domain.BusinessDay{Date: d, IsBusinessDay: true, Holidays: []domain.Holiday{}}, nil
+		}
+	}
+
+	return nil, domain.ErrNotFound
+}
+
+// businessCalendar 営業日判定用に年ごとの休日を読み込んで保持する
+type businessCalendar struct {
+	service  *BusinessDayService
+	opts     domain.BusinessDayOptions
+	weekend  map[time.Weekday]bool
+	holidays map[string][]domain.Holiday
+	loaded   map[int]bool
+}
+
+func (s *BusinessDayService) newBusinessCalendar(opts domain.BusinessDayOptions) (*businessCalendar, error) {
+	weekendDays := opts.Weekend
+	if len(weekendDays) == 0 {
+		weekendDays = defaultWeekend
+	}
+
+	weekend := make(map[time.Weekday]bool)
+	for _, wd := range weekendDays {
+		if wd < time.Sunday || wd > time.Saturday {
+			return nil, domain.ErrInvalidInput
+		}
+		weekend[wd] = true
+	}
+	if len(weekend) == 7 {
+		// 全ての曜日が休業では営業日が存在しない
+		return nil, domain.ErrInvalidInput
+	}
+
+	return &businessCalendar{
+		service:  s,
+		opts:     opts,
+		weekend:  weekend,
+		holidays: make(map[string][]domain.Holiday),
+		loaded:   make(map[int]bool),
+	}, nil
+}
+
+// holidaysOn 指定日の祝日・休日
+func (bc *businessCalendar) holidaysOn(date time.Time) ([]domain.Holiday, error) {
+	if err := bc.load(date.Year()); err != nil {
+		return nil, err
+	}
+	holidays := bc.holidays[date.Format("2006-01-02")]
+	if holidays == nil {
+		holidays = []domain.Holiday{}
+	}
+	return holidays, nil
+}
+
+// isBusinessDay 休業曜日でも祝日・休日でもない日かどうか
+func (bc *businessCalendar) isBusinessDay(date time.Time) (bool, error) {
+	if bc.weekend[date.Weekday()] {
+		return false, nil
+	}
+	holidays, err := bc.holidaysOn(date)
+	if err != nil {
+		return false, err
+	}
+	return len(holidays) == 0, nil
+}
+
+// load 指定年の祝日（と独自の休日）を読み込む
+func (bc *businessCalendar) load(year int) error {
+	if bc.loaded[year] {
+		return nil
+	}
+
+	holidays, err := bc.service.calendar.GetHolidaysForRegions(year, bc.opts.Regions)
+	if err != nil {
+		return err
+	}
+	if bc.opts.IncludeCustom {
+		first := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		customs, err := bc.service.calendar.GetCustomHolidays(first, first.AddDate(1, 0, -1))
+		if err != nil {
+			return err
+		}
+		holidays = append(holidays, customs...)
+	}
+
+	for _, h := range holidays {
+		key := h.Date.Format("2006-01-02")
+		bc.holidays[key] = append(bc.holidays[key], h)
+	}
+	bc.loaded[year] = true
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+func newBusinessDayTestService() *BusinessDayService {
+	repo := &MockCustomHolidayRepository{
+		GetByDateRangeFunc: func(start, end time.Time) ([]domain.CustomHoliday, error) {
+			return []domain.CustomHoliday{
+				{
+					ID:        1,
+					Name:      "年末年始休業",
+					StartDate: time.Date(2020, 12, 29, 0, 0, 0, 0, time.UTC),
+					EndDate:   time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+					Recurring: true,
+				},
+			}, nil
+		},
+	}
+	return NewBusinessDayService(NewCalendarService(repo, &MockEventRepository{}))
+}
+
+func TestNewBusinessDayService(t *testing.T) {
+	service := newBusinessDayTestService()
+
+	if service == nil {
+		t.Error("NewBusinessDayService should return a non-nil service")
+	}
+}
+
+func TestBusinessDayService_CheckBusinessDay(t *testing.T) {
+	service := newBusinessDayTestService()
+
+	tests := []struct {
+		date      time.Time
+		opts      domain.BusinessDayOptions
+		expected  bool
+		isWeekend bool
+	}{
+		{time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC), domain.BusinessDayOptions{}, false, false},
+		{time.Date(2025, 5, 6, 0, 0, 0, 0, time.UTC), domain.BusinessDayOptions{}, false, false},
+		{time.Date(2025, 5, 7, 0, 0, 0, 0, time.UTC), domain.BusinessDayOptions{}, true, false},
+		{time.Date(2025, 5, 10, 0, 0, 0, 0, time.UTC), domain.BusinessDayOptions{}, false, true},
+		// 金曜日・土曜日を休業とする場合
+		{time.Date(2025, 5, 9, 0, 0, 0, 0, time.UTC), domain.BusinessDayOptions{Weekend: []time.Weekday{time.Friday, time.Saturday}}, false, true},
+		{time.Date(2025, 5, 11, 0, 0, 0, 0, time.UTC), domain.BusinessDayOptions{Weekend: []time.Weekday{time.Friday, time.Saturday}}, true, false},
+		// 地域の指定
+		{time.Date(2025, 7, 4, 0, 0, 0, 0, time.UTC), domain.BusinessDayOptions{}, true, false},
+		{time.Date(2025, 7, 4, 0, 0, 0, 0, time.UTC), domain.BusinessDayOptions{Regions: []string{"US"}}, false, false},
+		// 独自休日は指定した場合のみ休業
+		{time.Date(2025, 12, 30, 0, 0, 0, 0, time.UTC), domain.BusinessDayOptions{}, true, false},
+		{time.Date(2025, 12, 30, 0, 0, 0, 0, time.UTC), domain.BusinessDayOptions{IncludeCustom: true}, false, false},
+	}
+
+	for _, test := range tests {
+		result, err := service.CheckBusinessDay(test.date, test.opts)
+		if err != nil {
+			t.Fatalf("%s: CheckBusinessDay should not return error: %v", test.date.Format("2006-01-02"), err)
+		}
+		if result.IsBusinessDay != test.expected || result.IsWeekend != test.isWeekend {
+			t.Errorf("%s: Expected business day %v (weekend %v), got %v (weekend %v)",
+				test.date.Format("2006-01-02"), test.expected, test.isWeekend, result.IsBusinessDay, result.IsWeekend)
+		}
+	}
+}
+
+func TestBusinessDayService_AddBusinessDays(t *testing.T) {
+	service := newBusinessDayTestService()
+
+	tests := []struct {
+		from     time.Time
+		n        int
+		opts     domain.BusinessDayOptions
+		expected time.Time
+	}{
+		// 昭和の日とゴールデンウィークを飛ばす
+		{time.Date(2025, 4, 28, 0, 0, 0, 0, time.UTC), 5, domain.BusinessDayOptions{}, time.Date(2025, 5, 8, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2025, 5, 7, 0, 0, 0, 0, time.UTC), -1, domain.BusinessDayOptions{}, time.Date(2025, 5, 2, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC), 0, domain.BusinessDayOptions{}, time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC)},
+		// 年末年始休業をまたぐ
+		{time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC), 1, domain.BusinessDayOptions{IncludeCustom: true}, time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC), 1, domain.BusinessDayOptions{}, time.Date(2025, 12, 29, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		result, err := service.AddBusinessDays(test.from, test.n, test.opts)
+		if err != nil {
+			t.Fatalf("%s%+d: AddBusinessDays should not return error: %v", test.from.Format("2006-01-02"), test.n, err)
+		}
+		if !result.Date.Equal(test.expected) {
+			t.Errorf("%s%+d: Expected %s, got %s", test.from.Format("2006-01-02"), test.n, test.expected.Format("2006-01-02"), result.Date.Format("2006-01-02"))
+		}
+		if result.Days != test.n || !result.From.Equal(test.from) {
+			t.Errorf("Unexpected shift: %+v", result)
+		}
+	}
+
+	if _, err := service.AddBusinessDays(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), domain.MaxBusinessDaySearch+1, domain.BusinessDayOptions{}); err != domain.ErrInvalidInput {
+		t.Errorf("Expected ErrInvalidInput for too many days, got %v", err)
+	}
+}
+
+func TestBusinessDayService_NextAndPreviousBusinessDay(t *testing.T) {
+	service := newBusinessDayTestService()
+	opts := domain.BusinessDayOptions{IncludeCustom: true}
+
+	next, err := service.NextBusinessDay(time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC), opts)
+	if err != nil {
+		t.Fatalf("NextBusinessDay should not return error: %v", err)
+	}
+	if !next.Date.Equal(time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected 2026-01-05, got %s", next.Date.Format("2006-01-02"))
+	}
+
+	previous, err := service.PreviousBusinessDay(time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC), opts)
+	if err != nil {
+		t.Fatalf("PreviousBusinessDay should not return error: %v", err)
+	}
+	if !previous.Date.Equal(time.Date(2024, 12, 27, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected 2024-12-27, got %s", previous.Date.Format("2006-01-02"))
+	}
+}
+
+func TestBusinessDayService_CountBusinessDays(t *testing.T) {
+	service := newBusinessDayTestService()
+
+	result, err := service.CountBusinessDays(
+		time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 5, 31, 0, 0, 0, 0, time.UTC),
+		domain.BusinessDayOptions{},
+	)
+	if err != nil {
+		t.Fatalf("CountBusinessDays should not return error: %v", err)
+	}
+
+	// 31日 - 土日9日 - こどもの日・振替休日
+	if result.BusinessDays != 20 || result.CalendarDays != 31 {
+		t.Errorf("Expected 20 business days in 31 days, got %d in %d", result.BusinessDays, result.CalendarDays)
+	}
+
+	if _, err := service.CountBusinessDays(
+		time.Date(2025, 5, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC),
+		domain.BusinessDayOptions{},
+	); err != domain.ErrInvalidInput {
+		t.Errorf("Expected ErrInvalidInput for reversed range, got %v", err)
+	}
+}
+
+func TestBusinessDayService_InvalidOptions(t *testing.T) {
+	service := newBusinessDayTestService()
+	date := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	allWeek := domain.BusinessDayOptions{Weekend: []time.Weekday{0, 1, 2, 3, 4, 5, 6}}
+	if _, err := service.NextBusinessDay(date, allWeek); err != domain.ErrInvalidInput {
+		t.Errorf("Expected ErrInvalidInput when every day is a weekend, got %v", err)
+	}
+
+	if _, err := service.CheckBusinessDay(date, domain.BusinessDayOptions{Regions: []string{"XX"}}); err != domain.ErrInvalidInput {
+		t.Errorf("Expected ErrInvalidInput for unknown region, got %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// maxNthBusinessDay 第N営業日として指定できる最大の N（1か月の営業日数の上限）
+const maxNthBusinessDay = 23
+
+// nthBusinessDay 月（first〜last）の第N営業日（N が負の場合は月末から数える）
+// 営業日が N 日に満たない月は回を作らない
+func (r *recurrenceRule) nthBusinessDay(first, last time.Time) []time.Time {
+	step, remaining, d := 1, r.BusinessDay, first
+	if r.BusinessDay < 0 {
+		step, remaining, d = -1, -r.BusinessDay, last
+	}
+
+	for ; !d.Before(first) && !d.After(last); d = d.AddDate(0, 0, step) {
+		ok, err := r.calendar.isBusinessDay(d)
+		if err != nil {
+			r.err = err
+			return []time.Time{}
+		}
+		if ok {
+			remaining--
+			if remaining == 0 {
+				return []time.Time{d}
+			}
+		}
+	}
+	return []time.Time{}
+}
+
+// recurrenceShiftStep 休業日に当たる回を移動する方向（-1: 前営業日、1: 翌営業日、0: 移動しない）
+func recurrenceShiftStep(rule *domain.EventBusinessRule) int {
+	if rule == nil {
+		return 0
+	}
+	switch rule.Shift {
+	case domain.RecurrenceShiftPrevious:
+		return -1
+	case domain.RecurrenceShiftNext:
+		return 1
+	}
+	return 0
+}
+
+// shiftOccurrence 本来の日時 t の回が休業日に当たる場合に、step の方向の営業日の同じ時刻に移した日時
+func shiftOccurrence(bc *businessCalendar, t time.Time, step int) (time.Time, error) {
+	if step == 0 {
+		return t, nil
+	}
+	date, err := moveToBusinessDay(bc, time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC), step)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location()), nil
+}
+
+// ruleOccurrences RRULE に従う回のうち、移動後の開始日時が horizon までの回（古い順）
+// 回は本来の日時で数え、休業日の移動を指定した場合は前営業日・翌営業日に移した日時を開始日時とする。
+// 移動して同じ日時に重なった回は、本来の日時が最も早い回にまとめる
+func ruleOccurrences(event domain.Event, rule *recurrenceRule, dtstart, horizon time.Time) ([]occurrenceTime, error) {
+	step := recurrenceShiftStep(event.BusinessRule)
+	limit := horizon
+	if step < 0 {
+		// 期間の後の回が前営業日に移って期間に入る場合があるため、移しうる最大の日数だけ先まで展開する
+		limit = horizon.AddDate(0, 0, domain.MaxBusinessDaySearch)
+	}
+
+	result := []occurrenceTime{}
+	rule.occurrences(dtstart, limit, func(t time.Time) bool {
+		if t.After(limit) {
+			return false
+		}
+		start, err := shiftOccurrence(rule.calendar, t, step)
+		if err != nil {
+			rule.err = err
+			return false
+		}
+		// 移動後の開始日時は本来の日時と同じ順に並ぶため、horizon を過ぎた回より後に horizon までの回はない
+		if start.After(horizon) {
+			return false
+		}
+		if len(result) == 0 || !result[len(result)-1].Start.Equal(start) {
+			result = append(result, occurrenceTime{ID: t, Start: start})
+		}
+		return true
+	})
+	if rule.err != nil {
+		return nil, rule.err
+	}
+	return result, nil
+}
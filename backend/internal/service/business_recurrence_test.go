@@ -0,0 +1,355 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// newBusinessRecurrenceTestCalendar 年末年始休業（12月29日〜1月3日）を独自休日とするカレンダー
+func newBusinessRecurrenceTestCalendar() *CalendarService {
+	repo := &MockCustomHolidayRepository{
+		GetByDateRangeFunc: func(start, end time.Time) ([]domain.CustomHoliday, error) {
+			return []domain.CustomHoliday{
+				{
+					ID:        1,
+					Name:      "年末年始休業",
+					StartDate: time.Date(2020, 12, 29, 0, 0, 0, 0, time.UTC),
+					EndDate:   time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+					Recurring: true,
+				},
+			}, nil
+		},
+	}
+	return NewCalendarService(repo, &MockEventRepository{})
+}
+
+func TestExpandEvents_BusinessRules(t *testing.T) {
+	jst, _ := time.LoadLocation("Asia/Tokyo")
+	calendar := newBusinessRecurrenceTestCalendar()
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		rrule    string
+		rule     domain.EventBusinessRule
+		from     time.Time
+		end      time.Time
+		expected []string
+	}{
+		{
+			name:     "月末営業日",
+			start:    time.Date(2025, 1, 31, 18, 0, 0, 0, jst),
+			rrule:    "FREQ=MONTHLY",
+			rule:     domain.EventBusinessRule{BusinessDay: -1},
+			from:     time.Date(2025, 1, 1, 0, 0, 0, 0, jst),
+			end:      time.Date(2025, 8, 31, 23, 59, 59, 0, jst),
+			expected: []string{"2025-01-31 18:00", "2025-02-28 18:00", "2025-03-31 18:00", "2025-04-30 18:00", "2025-05-30 18:00", "2025-06-30 18:00", "2025-07-31 18:00", "2025-08-29 18:00"},
+		},
+		{
+			name:     "第3営業日（元日を除く）",
+			start:    time.Date(2025, 1, 1, 9, 0, 0, 0, jst),
+			rrule:    "FREQ=MONTHLY;COUNT=2",
+			rule:     domain.EventBusinessRule{BusinessDay: 3},
+			from:     time.Date(2025, 1, 1, 0, 0, 0, 0, jst),
+			end:      time.Date(2025, 12, 31, 0, 0, 0, 0, jst),
+			expected: []string{"2025-01-06 09:00", "2025-02-05 09:00"},
+		},
+		{
+			name:     "第3営業日（年末年始休業を除く）",
+			start:    time.Date(2025, 1, 1, 9, 0, 0, 0, jst),
+			rrule:    "FREQ=MONTHLY;COUNT=2",
+			rule:     domain.EventBusinessRule{BusinessDay: 3, IncludeCustom: true},
+			from:     time.Date(2025, 1, 1, 0, 0, 0, 0, jst),
+			end:      time.Date(2025, 12, 31, 0, 0, 0, 0, jst),
+			expected: []string{"2025-01-08 09:00", "2025-02-05 09:00"},
+		},
+		{
+			name:     "毎年の仕事始め",
+			start:    time.Date(2025, 1, 1, 10, 0, 0, 0, jst),
+			rrule:    "FREQ=YEARLY",
+			rule:     domain.EventBusinessRule{BusinessDay: 1, IncludeCustom: true},
+			from:     time.Date(2025, 1, 1, 0, 0, 0, 0, jst),
+			end:      time.Date(2026, 12, 31, 0, 0, 0, 0, jst),
+			expected: []string{"2025-01-06 10:00", "2026-01-05 10:00"},
+		},
+		{
+			name:     "休日の場合は翌営業日（成人の日）",
+			start:    time.Date(2025, 1, 6, 10, 0, 0, 0, jst),
+			rrule:    "FREQ=WEEKLY;COUNT=3",
+			rule:     domain.EventBusinessRule{Shift: domain.RecurrenceShiftNext},
+			from:     time.Date(2025, 1, 1, 0, 0, 0, 0, jst),
+			end:      time.Date(2025, 1, 31, 0, 0, 0, 0, jst),
+			expected: []string{"2025-01-06 10:00", "2025-01-14 10:00", "2025-01-20 10:00"},
+		},
+		{
+			// 期間の後の1月13日の回が前営業日の1月10日に移って期間に入る
+			name:     "休日の場合は前営業日",
+			start:    time.Date(2025, 1, 6, 10, 0, 0, 0, jst),
+			rrule:    "FREQ=WEEKLY;COUNT=3",
+			rule:     domain.EventBusinessRule{Shift: domain.RecurrenceShiftPrevious},
+			from:     time.Date(2025, 1, 1, 0, 0, 0, 0, jst),
+			end:      time.Date(2025, 1, 11, 0, 0, 0, 0, jst),
+			expected: []string{"2025-01-06 10:00", "2025-01-10 10:00"},
+		},
+		{
+			name:     "毎月25日（土日祝日は前営業日）",
+			start:    time.Date(2025, 1, 25, 0, 0, 0, 0, jst),
+			rrule:    "FREQ=MONTHLY;COUNT=6",
+			rule:     domain.EventBusinessRule{Shift: domain.RecurrenceShiftPrevious},
+			from:     time.Date(2025, 1, 1, 0, 0, 0, 0, jst),
+			end:      time.Date(2025, 12, 31, 0, 0, 0, 0, jst),
+			expected: []string{"2025-01-24 00:00", "2025-02-25 00:00", "2025-03-25 00:00", "2025-04-25 00:00", "2025-05-23 00:00", "2025-06-25 00:00"},
+		},
+	}
+
+	for _, test := range tests {
+		rule := test.rule
+		event := domain.Event{
+			ID:           1,
+			Title:        test.name,
+			StartDate:    test.start,
+			EndDate:      test.start.Add(time.Hour),
+			TimeZone:     "Asia/Tokyo",
+			RRule:        test.rrule,
+			BusinessRule: &rule,
+		}
+		events, err := expandEvents([]domain.Event{event}, test.from, test.end, calendar)
+		if err != nil {
+			t.Fatalf("%s: Unexpected error: %v", test.name, err)
+		}
+		got := []string{}
+		for _, occurrence := range events {
+			got = append(got, occurrence.StartDate.In(jst).Format("2006-01-02 15:04"))
+		}
+		if !equalStrings(got, test.expected) {
+			t.Errorf("%s: Expected %v, got %v", test.name, test.expected, got)
+		}
+	}
+}
+
+func TestExpandEvents_BusinessRuleExDate(t *testing.T) {
+	jst, _ := time.LoadLocation("Asia/Tokyo")
+	event := domain.Event{
+		ID:           1,
+		Title:        "定例会",
+		StartDate:    time.Date(2025, 1, 6, 10, 0, 0, 0, jst),
+		EndDate:      time.Date(2025, 1, 6, 11, 0, 0, 0, jst),
+		TimeZone:     "Asia/Tokyo",
+		RRule:        "FREQ=WEEKLY;COUNT=3",
+		ExDates:      []time.Time{time.Date(2025, 1, 13, 10, 0, 0, 0, jst)},
+		BusinessRule: &domain.EventBusinessRule{Shift: domain.RecurrenceShiftNext},
+	}
+
+	// EXDATE は移動する前の本来の日時で指定する（1月13日の回は成人の日のため1月14日に移る）
+	events, err := expandEvents([]domain.Event{event}, time.Date(2025, 1, 1, 0, 0, 0, 0, jst), time.Date(2025, 1, 31, 0, 0, 0, 0, jst), newBusinessRecurrenceTestCalendar())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(events) != 2 || !events[1].StartDate.Equal(time.Date(2025, 1, 20, 10, 0, 0, 0, jst)) {
+		t.Errorf("Expected the shifted occurrence to be excluded, got %+v", events)
+	}
+
+	event.ExDates = nil
+	events, err = expandEvents([]domain.Event{event}, time.Date(2025, 1, 1, 0, 0, 0, 0, jst), time.Date(2025, 1, 31, 0, 0, 0, 0, jst), newBusinessRecurrenceTestCalendar())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(events) != 3 || !events[1].StartDate.Equal(time.Date(2025, 1, 14, 10, 0, 0, 0, jst)) || !events[1].RecurrenceID.Equal(time.Date(2025, 1, 13, 10, 0, 0, 0, jst)) {
+		t.Errorf("Expected the shifted occurrence to keep its original recurrence ID, got %+v", events)
+	}
+}
+
+func TestExpandEvents_BusinessRuleLongClosure(t *testing.T) {
+	jst, _ := time.LoadLocation("Asia/Tokyo")
+	// 2週間を超える休業（2月10日〜3月20日）
+	calendar := NewCalendarService(&MockCustomHolidayRepository{
+		GetByDateRangeFunc: func(start, end time.Time) ([]domain.CustomHoliday, error) {
+			return []domain.CustomHoliday{
+				{
+					ID:        1,
+					Name:      "改装休業",
+					StartDate: time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC),
+					EndDate:   time.Date(2025, 3, 20, 0, 0, 0, 0, time.UTC),
+				},
+			}, nil
+		},
+	}, &MockEventRepository{})
+
+	event := domain.Event{
+		ID:           1,
+		Title:        "月初の報告",
+		StartDate:    time.Date(2025, 1, 1, 10, 0, 0, 0, jst),
+		EndDate:      time.Date(2025, 1, 1, 11, 0, 0, 0, jst),
+		TimeZone:     "Asia/Tokyo",
+		RRule:        "FREQ=MONTHLY",
+		BusinessRule: &domain.EventBusinessRule{Shift: domain.RecurrenceShiftPrevious, IncludeCustom: true},
+	}
+
+	// 3月1日の回は休業前の2月7日に移り、期間（2月1日〜9日）に入る
+	events, err := expandEvents([]domain.Event{event}, time.Date(2025, 2, 1, 0, 0, 0, 0, jst), time.Date(2025, 2, 9, 23, 59, 59, 0, jst), calendar)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(events) != 1 || !events[0].StartDate.Equal(time.Date(2025, 2, 7, 10, 0, 0, 0, jst)) || !events[0].RecurrenceID.Equal(time.Date(2025, 3, 1, 10, 0, 0, 0, jst)) {
+		t.Errorf("Expected the 03-01 occurrence on 02-07, got %+v", events)
+	}
+
+	// 翌営業日に移す場合、3月1日の回は休業明けの3月21日に移っても本来の日時で見つかる
+	event.BusinessRule = &domain.EventBusinessRule{Shift: domain.RecurrenceShiftNext, IncludeCustom: true}
+	occurrence, ok, err := findOccurrence(event, time.Date(2025, 3, 1, 10, 0, 0, 0, jst), calendar)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !ok || !occurrence.StartDate.Equal(time.Date(2025, 3, 21, 10, 0, 0, 0, jst)) {
+		t.Errorf("Expected the 03-01 occurrence on 03-21, got %v %+v", ok, occurrence)
+	}
+}
+
+func TestEventService_CreateEvent_BusinessRule(t *testing.T) {
+	jst, _ := time.LoadLocation("Asia/Tokyo")
+	calendar := newBusinessRecurrenceTestCalendar()
+	repo := &MockEventRepository{
+		CreateFunc: func(event *domain.Event) error {
+			event.ID = 1
+			return nil
+		},
+	}
+
+	tests := []struct {
+		name     string
+		rrule    string
+		rule     domain.EventBusinessRule
+		calendar HolidayCalendarInterface
+		expected error
+	}{
+		{"月末営業日", "FREQ=MONTHLY", domain.EventBusinessRule{BusinessDay: -1, Shift: domain.RecurrenceShiftNone}, calendar, nil},
+		{"RRULE なし", "", domain.EventBusinessRule{BusinessDay: 1}, calendar, domain.ErrInvalidInput},
+		{"不明な移動", "FREQ=MONTHLY", domain.EventBusinessRule{Shift: "later"}, calendar, domain.ErrInvalidInput},
+		{"毎週の第N営業日", "FREQ=WEEKLY", domain.EventBusinessRule{BusinessDay: 1}, calendar, domain.ErrInvalidInput},
+		{"BYMONTHDAY との組み合わせ", "FREQ=MONTHLY;BYMONTHDAY=1", domain.EventBusinessRule{BusinessDay: 1}, calendar, domain.ErrInvalidInput},
+		{"範囲外の営業日", "FREQ=MONTHLY", domain.EventBusinessRule{BusinessDay: 24}, calendar, domain.ErrInvalidInput},
+		{"不明な地域", "FREQ=MONTHLY", domain.EventBusinessRule{BusinessDay: 1, Regions: []string{"XX"}}, calendar, domain.ErrInvalidInput},
+		{"カレンダーなし", "FREQ=MONTHLY", domain.EventBusinessRule{BusinessDay: 1}, nil, domain.ErrInvalidInput},
+	}
+
+	for _, test := range tests {
+		rule := test.rule
+		event := &domain.Event{
+			Title:        test.name,
+			StartDate:    time.Date(2025, 1, 31, 18, 0, 0, 0, jst),
+			EndDate:      time.Date(2025, 1, 31, 19, 0, 0, 0, jst),
+			RRule:        test.rrule,
+			BusinessRule: &rule,
+		}
+		if err := NewEventService(repo, test.calendar).CreateEvent(event); err != test.expected {
+			t.Errorf("%s: Expected %v, got %v", test.name, test.expected, err)
+		}
+	}
+}
+
+func TestEventService_UpdateEventOccurrences_BusinessRule(t *testing.T) {
+	jst, _ := time.LoadLocation("Asia/Tokyo")
+	var created, updated []domain.Event
+	repo := newRecurringEventTestRepository("FREQ=WEEKLY;COUNT=10", &created, &updated)
+	getByID := repo.GetByIDFunc
+	repo.GetByIDFunc = func(id int) (*domain.Event, error) {
+		series, err := getByID(id)
+		if series != nil {
+			series.BusinessRule = &domain.EventBusinessRule{Shift: domain.RecurrenceShiftNext}
+		}
+		return series, err
+	}
+	service := NewEventService(repo, newBusinessRecurrenceTestCalendar())
+
+	// 成人の日（1月13日）の回は1月14日に移っているが、本来の日時で指定する
+	recurrenceID := time.Date(2025, 1, 13, 10, 0, 0, 0, jst)
+	event := &domain.Event{
+		ID:        1,
+		Title:     "定例会（会議室変更）",
+		StartDate: time.Date(2025, 1, 14, 14, 0, 0, 0, jst),
+		EndDate:   time.Date(2025, 1, 14, 15, 0, 0, 0, jst),
+	}
+	if err := service.UpdateEventOccurrences(event, domain.EventEditScopeThis, recurrenceID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(created) != 1 || !created[0].RecurrenceID.Equal(recurrenceID) {
+		t.Errorf("Expected the shifted occurrence to be detached, got %+v", created)
+	}
+
+	if err := service.UpdateEventOccurrences(event, domain.EventEditScopeThis, time.Date(2025, 1, 14, 10, 0, 0, 0, jst)); err != domain.ErrNotFound {
+		t.Errorf("Expected ErrNotFound for the shifted date, got %v", err)
+	}
+}
+
+func TestEventService_UpdateEventOccurrences_BusinessRuleFollowing(t *testing.T) {
+	jst, _ := time.LoadLocation("Asia/Tokyo")
+	calendar := newBusinessRecurrenceTestCalendar()
+	series := domain.Event{
+		ID:           1,
+		Title:        "月次報告",
+		StartDate:    time.Date(2025, 4, 1, 10, 0, 0, 0, jst),
+		EndDate:      time.Date(2025, 4, 1, 11, 0, 0, 0, jst),
+		TimeZone:     "Asia/Tokyo",
+		RRule:        "FREQ=MONTHLY",
+		BusinessRule: &domain.EventBusinessRule{Shift: domain.RecurrenceShiftNext},
+	}
+	var created, updated []domain.Event
+	repo := &MockEventRepository{
+		GetByIDFunc: func(id int) (*domain.Event, error) {
+			event := series
+			return &event, nil
+		},
+		UpdateSeriesFunc: func(series, event *domain.Event) error {
+			event.ID = 2
+			created = append(created, *event)
+			updated = append(updated, *series)
+			return nil
+		},
+	}
+	service := NewEventService(repo, calendar)
+
+	// 6月1日（日）の回は翌営業日の6月2日に移っている。移動後の回を表示どおりの日時で変更する
+	occurrences, err := expandEvents([]domain.Event{series}, time.Date(2025, 6, 1, 0, 0, 0, 0, jst), time.Date(2025, 6, 30, 0, 0, 0, 0, jst), calendar)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(occurrences) != 1 || !occurrences[0].StartDate.Equal(time.Date(2025, 6, 2, 10, 0, 0, 0, jst)) {
+		t.Fatalf("Expected the June occurrence on 2025-06-02, got %+v", occurrences)
+	}
+	event := &domain.Event{
+		ID:           1,
+		Title:        "月次報告（オンライン）",
+		StartDate:    occurrences[0].StartDate,
+		EndDate:      occurrences[0].EndDate,
+		RRule:        "FREQ=MONTHLY",
+		BusinessRule: &domain.EventBusinessRule{Shift: domain.RecurrenceShiftNext},
+	}
+	if err := service.UpdateEventOccurrences(event, domain.EventEditScopeFollowing, *occurrences[0].RecurrenceID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(created) != 1 || len(updated) != 1 {
+		t.Fatalf("Expected 1 create and 1 update, got %d and %d", len(created), len(updated))
+	}
+	if updated[0].RRule != "FREQ=MONTHLY;UNTIL=20250601T005959Z" {
+		t.Errorf("Expected the series to end before the original date, got %s", updated[0].RRule)
+	}
+	if !created[0].StartDate.Equal(time.Date(2025, 6, 1, 10, 0, 0, 0, jst)) {
+		t.Errorf("Expected the new series to start on the original date, got %v", created[0].StartDate)
+	}
+
+	// 6月2日の回はどちらか一方のシリーズにだけ現れる
+	expanded, err := expandEvents([]domain.Event{updated[0], created[0]}, time.Date(2025, 4, 1, 0, 0, 0, 0, jst), time.Date(2025, 8, 31, 0, 0, 0, 0, jst), calendar)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got := []string{}
+	for _, occurrence := range expanded {
+		got = append(got, occurrence.StartDate.In(jst).Format("2006-01-02")+"#"+occurrence.Title)
+	}
+	expected := []string{"2025-04-01#月次報告", "2025-05-01#月次報告", "2025-06-02#月次報告（オンライン）", "2025-07-01#月次報告（オンライン）", "2025-08-01#月次報告（オンライン）"}
+	if !equalStrings(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
@@ -1,119 +1,516 @@
 package service
 
 import (
-	"strconv"
+	"sort"
 	"time"
 
 	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
 )
 
-type CalendarService struct{}
+var (
+	// substituteHolidayStart 振替休日の施行日
+	substituteHolidayStart = time.Date(1973, 4, 12, 0, 0, 0, 0, time.UTC)
+	// citizensHolidayStart 国民の休日の施行日
+	citizensHolidayStart = time.Date(1985, 12, 27, 0, 0, 0, 0, time.UTC)
+)
+
+type CalendarService struct {
+	customRepo CustomHolidayRepositoryInterface
+	eventRepo  EventRepositoryInterface
+	holidays   *HolidayRegistry
+	official   *officialHolidays
+}
+
+func NewCalendarService(customRepo CustomHolidayRepositoryInterface, eventRepo EventRepositoryInterface) *CalendarService {
+	s := &CalendarService{customRepo: customRepo, eventRepo: eventRepo}
+	s.holidays = NewHolidayRegistry(
+		&japanHolidayProvider{calendar: s},
+		&usHolidayProvider{},
+		&taiwanHolidayProvider{},
+	)
+	return s
+}
 
-func NewCalendarService() *CalendarService {
-	return &CalendarService{}
+// HolidayRegions 祝日を取得できる国・地域コードの一覧
+func (s *CalendarService) HolidayRegions() []string {
+	return s.holidays.Regions()
+}
+
+// validateYear 指定年がカレンダー・暦の計算に対応する範囲内かを確認
+// 範囲外の年は旧暦・月齢などの天文計算を始める前に不正な入力として扱う
+func validateYear(year int) error {
+	if year < domain.MinSupportedYear || year > domain.MaxSupportedYear {
+		return domain.ErrInvalidInput
+	}
+	return nil
 }
 
 // GetCalendar 指定月のカレンダー情報を取得
-func (s *CalendarService) GetCalendar(year, month int) (*domain.Calendar, error) {
+// opts.Grid を指定した場合は前後の月の日付で埋めた7日ごとの週も返す
+func (s *CalendarService) GetCalendar(year, month int, opts domain.CalendarOptions) (*domain.Calendar, error) {
+	calendars, err := s.GetMonthCalendars(year, month, 1, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &calendars[0], nil
+}
+
+// GetMonthCalendars 指定月から months か月分の月次カレンダーを取得
+// 期間全体の各日を一度だけ作成して各月に振り分けるため、祝日・季節の暦・イベントの取得は1回で済む。
+// opts.Grid を指定した場合は各月に前後の月の日付で埋めた7日ごとの週も返す
+func (s *CalendarService) GetMonthCalendars(year, month, months int, opts domain.CalendarOptions) ([]domain.Calendar, error) {
+	if month < 1 || month > 12 || months < 1 {
+		return nil, domain.ErrInvalidInput
+	}
 	firstDay := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
-	lastDay := firstDay.AddDate(0, 1, -1)
+	lastDay := firstDay.AddDate(0, months, -1)
+	if err := validateYear(year); err != nil {
+		return nil, err
+	}
+	if err := validateYear(lastDay.Year()); err != nil {
+		return nil, err
+	}
+	if opts.WeekStart != time.Sunday && opts.WeekStart != time.Monday {
+		return nil, domain.ErrInvalidInput
+	}
 
-	calendar := &domain.Calendar{
-		Year:  year,
-		Month: month,
-		Days:  []domain.CalendarDay{},
+	rangeStart, rangeEnd := firstDay, lastDay
+	if opts.Grid {
+		rangeStart, rangeEnd = gridRange(firstDay, lastDay, opts.WeekStart)
+	}
+	days, err := s.buildCalendarDays(rangeStart, rangeEnd, opts)
+	if err != nil {
+		return nil, err
 	}
 
-	holidays := s.GetHolidays(year)
-	holidayMap := make(map[string]string)
-	for _, h := range holidays {
+	calendars := []domain.Calendar{}
+	for i := 0; i < months; i++ {
+		monthFirst := firstDay.AddDate(0, i, 0)
+		monthLast := monthFirst.AddDate(0, 1, -1)
+		calendar := domain.Calendar{
+			Year:  monthFirst.Year(),
+			Month: int(monthFirst.Month()),
+			Eras:  getEraYears(monthFirst, monthLast),
+			Days:  []domain.CalendarDay{},
+		}
+
+		if !opts.Grid {
+			offset := daysBetween(rangeStart, monthFirst)
+			calendar.Days = days[offset : offset+monthLast.Day()]
+			calendars = append(calendars, calendar)
+			continue
+		}
+
+		// 週の開始曜日から始まり、週の最終曜日で終わるように前後の月の日付で埋める
+		// 前後の月と共有する日は月ごとに OutsideMonth が異なるため複製して使う
+		gridStart, gridEnd := gridRange(monthFirst, monthLast, opts.WeekStart)
+		gridDays := append([]domain.CalendarDay{}, days[daysBetween(rangeStart, gridStart):daysBetween(rangeStart, gridEnd)+1]...)
+		for j := range gridDays {
+			if gridDays[j].Date.Month() != monthFirst.Month() {
+				gridDays[j].OutsideMonth = true
+				continue
+			}
+			calendar.Days = append(calendar.Days, gridDays[j])
+		}
+		calendar.Weeks = calendarWeeks(gridDays)
+		calendars = append(calendars, calendar)
+	}
+
+	return calendars, nil
+}
+
+// gridRange 期間（first〜last）を週の開始曜日から週の最終曜日までに広げた範囲を返す
+func gridRange(first, last time.Time, weekStart time.Weekday) (time.Time, time.Time) {
+	start := first.AddDate(0, 0, -((int(first.Weekday()) - int(weekStart) + 7) % 7))
+	end := last.AddDate(0, 0, 6-(int(last.Weekday())-int(weekStart)+7)%7)
+	return start, end
+}
+
+// daysBetween from から to までの日数（いずれもUTCの0時）
+func daysBetween(from, to time.Time) int {
+	return int(to.Sub(from) / (24 * time.Hour))
+}
+
+// GetWeekCalendar 指定年のISO週番号 week の週のカレンダー情報を取得
+// ISO週は月曜始まりで、opts.WeekStart が日曜日の場合は前日の日曜日から7日分を返す
+func (s *CalendarService) GetWeekCalendar(year, week int, opts domain.CalendarOptions) (*domain.CalendarWeek, error) {
+	if err := validateYear(year); err != nil {
+		return nil, err
+	}
+	if opts.WeekStart != time.Sunday && opts.WeekStart != time.Monday {
+		return nil, domain.ErrInvalidInput
+	}
+	_, weeksInYear := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	if week < 1 || week > weeksInYear {
+		return nil, domain.ErrInvalidInput
+	}
+
+	// 1月4日を含む週がISO週の第1週
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	monday := jan4.AddDate(0, 0, -((int(jan4.Weekday())+6)%7)+(week-1)*7)
+	start := monday.AddDate(0, 0, -int(time.Monday-opts.WeekStart))
+
+	days, err := s.buildCalendarDays(start, start.AddDate(0, 0, 6), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.CalendarWeek{ISOYear: year, ISOWeek: week, Days: days}, nil
+}
+
+// GetDayCalendar 指定日のカレンダー情報を取得
+func (s *CalendarService) GetDayCalendar(year, month, day int, opts domain.CalendarOptions) (*domain.CalendarDay, error) {
+	if err := validateYear(year); err != nil {
+		return nil, err
+	}
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if date.Year() != year || int(date.Month()) != month || date.Day() != day {
+		return nil, domain.ErrInvalidInput
+	}
+
+	days, err := s.buildCalendarDays(date, date, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &days[0], nil
+}
+
+// GetYearCalendar 指定年の12か月分のカレンダー情報を取得
+// 祝日・独自の休日・季節の暦は年単位で一度だけ計算して各月に振り分ける
+func (s *CalendarService) GetYearCalendar(year int, opts domain.CalendarOptions) (*domain.CalendarYear, error) {
+	opts.Grid = false
+	months, err := s.GetMonthCalendars(year, 1, 12, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	firstDay := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	lastDay := time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)
+	return &domain.CalendarYear{
+		Year:   year,
+		Eras:   getEraYears(firstDay, lastDay),
+		Months: months,
+	}, nil
+}
+
+// buildCalendarDays 期間内（start〜end、両端を含む）の各日のカレンダー情報を作成
+// 祝日と季節の暦は期間にかかる年ごとに一度だけ計算し、イベントは期間全体を一度で取得する。
+// opts.Location を指定した場合は各日の日付をそのタイムゾーンの0時で表す
+func (s *CalendarService) buildCalendarDays(start, end time.Time, opts domain.CalendarOptions) ([]domain.CalendarDay, error) {
+	if !supportedLanguage(opts.Language) {
+		return nil, domain.ErrInvalidInput
+	}
+
+	holidayMap := make(map[string][]domain.Holiday)
+	seasonalMap := make(map[string][]domain.SeasonalDay)
+	for year := start.Year(); year <= end.Year(); year++ {
+		holidays, err := s.holidaysForRegions(year, opts.Regions)
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range holidays {
+			key := h.Date.Format("2006-01-02")
+			holidayMap[key] = append(holidayMap[key], h)
+		}
+		for _, sd := range s.GetSeasonalDays(year) {
+			key := sd.Date.Format("2006-01-02")
+			seasonalMap[key] = append(seasonalMap[key], sd)
+		}
+	}
+
+	customs, err := s.GetCustomHolidays(start, end)
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range customs {
 		key := h.Date.Format("2006-01-02")
-		holidayMap[key] = h.Name
+		holidayMap[key] = append(holidayMap[key], h)
+	}
+
+	eventMap, err := s.getEventsByDay(start, end, opts.Location)
+	if err != nil {
+		return nil, err
 	}
 
-	for d := firstDay; !d.After(lastDay); d = d.AddDate(0, 0, 1) {
+	days := []domain.CalendarDay{}
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
 		dateKey := d.Format("2006-01-02")
-		holidayName, isHoliday := holidayMap[dateKey]
+		dayHolidays := s.LocalizeHolidays(holidayMap[dateKey], opts.Language)
+		holidayName := ""
+		if len(dayHolidays) > 0 {
+			holidayName = dayHolidays[0].Name
+		}
+		lunar := toLunarDate(d)
+		moonAge := calculateMoonAge(d)
+		seasonal := seasonalMap[dateKey]
+		if seasonal == nil {
+			seasonal = []domain.SeasonalDay{}
+		}
+		events := eventMap[dateKey]
+		if events == nil {
+			events = []domain.CalendarEvent{}
+		}
+
+		date := d
+		if opts.Location != nil {
+			date = time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, opts.Location)
+		}
 
 		day := domain.CalendarDay{
-			Date:      d,
-			Day:       d.Day(),
-			Weekday:   s.getWeekdayJapanese(d.Weekday()),
-			IsHoliday: isHoliday,
-			Holiday:   holidayName,
-			Rokuyo:    s.calculateRokuyo(d),
-			Events:    []domain.Event{},
+			Date:         date,
+			Day:          d.Day(),
+			Weekday:      localizeWeekday(d.Weekday(), opts.Language),
+			IsHoliday:    len(dayHolidays) > 0,
+			Holiday:      holidayName,
+			Holidays:     dayHolidays,
+			Rokuyo:       localizeRokuyo(rokuyoOf(lunar), opts.Language),
+			Lunar:        lunar,
+			MoonAge:      moonAge,
+			MoonPhase:    moonPhaseName(moonAge),
+			SeasonalDays: seasonal,
+			Eto:          sexagenaryName(sexagenaryDayIndex(d)),
+			Senjitsu:     calculateSenjitsu(d, lunar),
+			Events:       events,
 		}
 
-		calendar.Days = append(calendar.Days, day)
+		days = append(days, day)
 	}
 
-	return calendar, nil
+	return days, nil
+}
+
+// getEventsByDay 期間内のイベントを、イベントが続く各日に振り分けて取得
+// loc を指定した場合は時刻指定のイベントをそのタイムゾーンの日付で区切り、
+// 指定しない場合と終日イベントはイベント自身のタイムゾーンの日付で区切る
+func (s *CalendarService) getEventsByDay(start, end time.Time, loc *time.Location) (map[string][]domain.CalendarEvent, error) {
+	// タイムゾーンによる日付のずれを見込んで前後1日を含めて取得する
+	from, to := start.AddDate(0, 0, -1), end.AddDate(0, 0, 2)
+	events, err := s.eventRepo.GetByDateRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+	events, err = expandEvents(events, from, to, s)
+	if err != nil {
+		return nil, err
+	}
+
+	eventMap := make(map[string][]domain.CalendarEvent)
+	for _, event := range events {
+		zone := loc
+		if zone == nil || event.AllDay {
+			zone = eventLocation(event)
+		}
+		event = localizeEvent(event, zone)
+
+		first, last := eventDays(event)
+		for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+			if d.Before(start) || d.After(end) {
+				continue
+			}
+
+			position := domain.EventPositionMiddle
+			switch {
+			case first.Equal(last):
+				position = domain.EventPositionSingle
+			case d.Equal(first):
+				position = domain.EventPositionStart
+			case d.Equal(last):
+				position = domain.EventPositionEnd
+			}
+
+			if loc != nil {
+				event = localizeEvent(event, loc)
+			}
+			key := d.Format("2006-01-02")
+			eventMap[key] = append(eventMap[key], domain.CalendarEvent{Event: event, Position: position})
+		}
+	}
+
+	return eventMap, nil
+}
+
+// eventDays イベントが置かれる最初の日と最後の日（開始・終了日時のタイムゾーンの日付）
+// 終日イベントは終了日を含み、時刻指定のイベントが0時ちょうどに終わる場合はその前日までとする
+func eventDays(event domain.Event) (time.Time, time.Time) {
+	first := time.Date(event.StartDate.Year(), event.StartDate.Month(), event.StartDate.Day(), 0, 0, 0, 0, time.UTC)
+	last := time.Date(event.EndDate.Year(), event.EndDate.Month(), event.EndDate.Day(), 0, 0, 0, 0, time.UTC)
+
+	end := event.EndDate
+	atMidnight := end.Hour() == 0 && end.Minute() == 0 && end.Second() == 0 && end.Nanosecond() == 0
+	if !event.AllDay && atMidnight && end.After(event.StartDate) {
+		last = last.AddDate(0, 0, -1)
+	}
+	if last.Before(first) {
+		last = first
+	}
+
+	return first, last
+}
+
+// calendarWeeks 7日ごとに区切った週（行）と、その週のISO週番号
+// 日曜始まりの週もISO週の基準となる木曜日で番号を決める
+func calendarWeeks(days []domain.CalendarDay) []domain.CalendarWeek {
+	weeks := []domain.CalendarWeek{}
+	for i := 0; i+7 <= len(days); i += 7 {
+		row := days[i : i+7]
+		thursday := row[0].Date.AddDate(0, 0, (int(time.Thursday)-int(row[0].Date.Weekday())+7)%7)
+		isoYear, isoWeek := thursday.ISOWeek()
+		weeks = append(weeks, domain.CalendarWeek{
+			ISOYear: isoYear,
+			ISOWeek: isoWeek,
+			Days:    row,
+		})
+	}
+	return weeks
+}
+
+// GetCustomHolidays 期間内の組織独自の休日を1日ごとに取得
+func (s *CalendarService) GetCustomHolidays(start, end time.Time) ([]domain.Holiday, error) {
+	customs, err := s.customRepo.GetByDateRange(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return expandCustomHolidays(customs, start, end), nil
+}
+
+// GetHolidaysBetween 期間内（start〜end、両端を含む）の指定地域の祝日を日付順に取得
+// 期間は10年以内とする
+func (s *CalendarService) GetHolidaysBetween(start, end time.Time, regions []string) ([]domain.Holiday, error) {
+	start = truncateToDate(start)
+	end = truncateToDate(end)
+	if end.Before(start) || end.After(start.AddDate(10, 0, 0)) {
+		return nil, domain.ErrInvalidInput
+	}
+
+	result := []domain.Holiday{}
+	for year := start.Year(); year <= end.Year(); year++ {
+		holidays, err := s.GetHolidaysForRegions(year, regions)
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range holidays {
+			if !h.Date.Before(start) && !h.Date.After(end) {
+				result = append(result, h)
+			}
+		}
+	}
+
+	return result, nil
 }
 
 // GetHolidays 指定年の祝日一覧を取得
+// 内閣府の公表データの収録範囲内の年は公表データを、それ以外の年は計算結果を日付順に返す
 func (s *CalendarService) GetHolidays(year int) []domain.Holiday {
-	holidays := []domain.Holiday{}
-
-	// 固定祝日
-	fixedHolidays := map[string]string{
-		"01-01": "元日",
-		"02-11": "建国記念の日",
-		"02-23": "天皇誕生日",
-		"04-29": "昭和の日",
-		"05-03": "憲法記念日",
-		"05-04": "みどりの日",
-		"05-05": "こどもの日",
-		"08-11": "山の日",
-		"11-03": "文化の日",
-		"11-23": "勤労感謝の日",
-	}
-
-	for dateStr, name := range fixedHolidays {
-		date, _ := time.Parse("2006-01-02", strconv.Itoa(year)+"-"+dateStr)
-		holidays = append(holidays, domain.Holiday{Date: date, Name: name})
-	}
-
-	// 成人の日（1月第2月曜日）
-	holidays = append(holidays, domain.Holiday{
-		Date: s.getNthWeekday(year, 1, time.Monday, 2),
-		Name: "成人の日",
-	})
-
-	// 海の日（7月第3月曜日）
-	holidays = append(holidays, domain.Holiday{
-		Date: s.getNthWeekday(year, 7, time.Monday, 3),
-		Name: "海の日",
-	})
+	computed := s.computeHolidays(year)
+	if s.official.covers(year) {
+		return s.officialHolidaysOf(year, computed)
+	}
+	return computed
+}
 
-	// 敬老の日（9月第3月曜日）
-	holidays = append(holidays, domain.Holiday{
-		Date: s.getNthWeekday(year, 9, time.Monday, 3),
-		Name: "敬老の日",
-	})
+// computeHolidays 祝日法に基づいて指定年の祝日一覧を計算
+// 国民の祝日に加えて、祝日法第3条に基づく振替休日と国民の休日を含み、日付順に並べて返す
+func (s *CalendarService) computeHolidays(year int) []domain.Holiday {
+	national := s.getNationalHolidays(year)
+	substitutes := s.getSubstituteHolidays(national)
+	citizens := s.getCitizensHolidays(national, substitutes)
 
-	// スポーツの日（10月第2月曜日）
-	holidays = append(holidays, domain.Holiday{
-		Date: s.getNthWeekday(year, 10, time.Monday, 2),
-		Name: "スポーツの日",
-	})
+	holidays := make([]domain.Holiday, 0, len(national)+len(substitutes)+len(citizens))
+	holidays = append(holidays, national...)
+	holidays = append(holidays, substitutes...)
+	holidays = append(holidays, citizens...)
 
-	// 春分の日・秋分の日
-	holidays = append(holidays, domain.Holiday{
-		Date: s.calculateShunbun(year),
-		Name: "春分の日",
-	})
-	holidays = append(holidays, domain.Holiday{
-		Date: s.calculateShubun(year),
-		Name: "秋分の日",
+	sort.Slice(holidays, func(i, j int) bool {
+		return holidays[i].Date.Before(holidays[j].Date)
 	})
+	for i := range holidays {
+		holidays[i].Source = domain.HolidaySourceComputed
+	}
 
 	return holidays
 }
 
+// getSubstituteHolidays 振替休日を計算
+// 祝日が日曜日に当たるとき、2007年以降はその日以後で最も近い祝日でない日、
+// それ以前は翌日（1973年4月12日施行）を休日とする
+func (s *CalendarService) getSubstituteHolidays(holidays []domain.Holiday) []domain.Holiday {
+	holidaySet := makeHolidaySet(holidays)
+	substitutes := []domain.Holiday{}
+
+	for _, h := range holidays {
+		if h.Date.Weekday() != time.Sunday || h.Date.Before(substituteHolidayStart) {
+			continue
+		}
+
+		date := h.Date.AddDate(0, 0, 1)
+		if h.Date.Year() >= 2007 {
+			for holidaySet[date.Format("2006-01-02")] {
+				date = date.AddDate(0, 0, 1)
+			}
+		} else if holidaySet[date.Format("2006-01-02")] {
+			continue
+		}
+
+		substitutes = append(substitutes, domain.Holiday{Date: date, Name: "振替休日", Type: domain.HolidayTypeNational, Method: domain.HolidayMethodSubstitute})
+	}
+
+	return substitutes
+}
+
+// getCitizensHolidays 国民の休日を計算
+// 前日と翌日がともに国民の祝日である祝日でない日を休日とする（1985年12月27日施行）。
+// 振替休日に当たる日は除き、2006年以前は日曜日も除く
+func (s *CalendarService) getCitizensHolidays(national, substitutes []domain.Holiday) []domain.Holiday {
+	holidaySet := makeHolidaySet(national)
+	substituteSet := makeHolidaySet(substitutes)
+	citizens := []domain.Holiday{}
+
+	for _, h := range national {
+		date := h.Date.AddDate(0, 0, 1)
+		key := date.Format("2006-01-02")
+		if holidaySet[key] || substituteSet[key] || !holidaySet[date.AddDate(0, 0, 1).Format("2006-01-02")] {
+			continue
+		}
+		if date.Before(citizensHolidayStart) {
+			continue
+		}
+		if date.Year() < 2007 && date.Weekday() == time.Sunday {
+			continue
+		}
+
+		citizens = append(citizens, domain.Holiday{Date: date, Name: "国民の休日", Type: domain.HolidayTypeNational, Method: domain.HolidayMethodCitizens})
+	}
+
+	return citizens
+}
+
+// makeHolidaySet 祝日の日付集合を作成
+func makeHolidaySet(holidays []domain.Holiday) map[string]bool {
+	set := make(map[string]bool, len(holidays))
+	for _, h := range holidays {
+		set[h.Date.Format("2006-01-02")] = true
+	}
+	return set
+}
+
 // getNthWeekday 指定月のN番目の曜日を取得
 func (s *CalendarService) getNthWeekday(year, month int, weekday time.Weekday, n int) time.Time {
-	firstDay := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+	return nthWeekday(year, time.Month(month), weekday, n)
+}
+
+// nthWeekday 指定月のN番目の曜日を取得（n が負の場合は月末から数えて|n|番目）
+func nthWeekday(year int, month time.Month, weekday time.Weekday, n int) time.Time {
+	if n < 0 {
+		lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC)
+		daysSinceWeekday := int(lastDay.Weekday() - weekday)
+		if daysSinceWeekday < 0 {
+			daysSinceWeekday += 7
+		}
+		return lastDay.AddDate(0, 0, -daysSinceWeekday+(n+1)*7)
+	}
+
+	firstDay := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
 
 	// 最初の指定曜日を見つける
 	daysUntilWeekday := int(weekday - firstDay.Weekday())
@@ -125,40 +522,51 @@ func (s *CalendarService) getNthWeekday(year, month int, weekday time.Weekday, n
 	return firstDay.AddDate(0, 0, daysUntilWeekday+(n-1)*7)
 }
 
-// calculateShunbun 春分の日を計算（簡易版）
+// calculateShunbun 春分の日を計算（太陽黄経0度となる日本時間の日付）
 func (s *CalendarService) calculateShunbun(year int) time.Time {
-	// 簡易計算式（2000-2099年まで有効）
-	day := 20
-	if year >= 2000 && year <= 2099 {
-		day = int(20.8431+0.242194*(float64(year)-1980)) - (year-1980)/4
-	}
-	return time.Date(year, 3, day, 0, 0, 0, 0, time.UTC)
+	return s.calculateEquinox(year, 0)
 }
 
-// calculateShubun 秋分の日を計算（簡易版）
+// calculateShubun 秋分の日を計算（太陽黄経180度となる日本時間の日付）
 func (s *CalendarService) calculateShubun(year int) time.Time {
-	// 簡易計算式（2000-2099年まで有効）
-	day := 23
-	if year >= 2000 && year <= 2099 {
-		day = int(23.2488+0.242194*(float64(year)-1980)) - (year-1980)/4
+	return s.calculateEquinox(year, 180)
+}
+
+// calculateEquinox 太陽黄経が指定角度（0度または180度）となる日本時間の日付を計算
+func (s *CalendarService) calculateEquinox(year int, longitude float64) time.Time {
+	return equinoxDate(year, longitude)
+}
+
+// equinoxDate 太陽黄経が指定角度（0度または180度）となる日本時間の日付を計算
+func equinoxDate(year int, longitude float64) time.Time {
+	guess := time.Date(year, time.March, 20, 0, 0, 0, 0, time.UTC)
+	if longitude == 180 {
+		guess = time.Date(year, time.September, 23, 0, 0, 0, 0, time.UTC)
 	}
-	return time.Date(year, 9, day, 0, 0, 0, 0, time.UTC)
+	return dateInJST(solarTermTime(longitude, guess))
 }
 
 // calculateRokuyo 六曜を計算
+// 旧暦の月と日の和を6で割った余りで決まる（閏月は同じ月番号として扱う）
 func (s *CalendarService) calculateRokuyo(date time.Time) string {
-	rokuyo := []string{"大安", "赤口", "先勝", "友引", "先負", "仏滅"}
+	return rokuyoOf(toLunarDate(date))
+}
 
-	// 旧暦計算の簡易版（正確な旧暦変換ではなく近似値）
-	month := int(date.Month())
-	day := date.Day()
+// rokuyoOf 旧暦の日付から六曜を取得
+func rokuyoOf(lunar domain.LunarDate) string {
+	rokuyo := []string{"大安", "赤口", "先勝", "友引", "先負", "仏滅"}
 
-	index := (month + day) % 6
+	index := (lunar.Month + lunar.Day) % 6
 	return rokuyo[index]
 }
 
 // getWeekdayJapanese 曜日の日本語名を取得
 func (s *CalendarService) getWeekdayJapanese(weekday time.Weekday) string {
+	return japaneseWeekdayName(weekday)
+}
+
+// japaneseWeekdayName 曜日の日本語名
+func japaneseWeekdayName(weekday time.Weekday) string {
 	weekdays := map[time.Weekday]string{
 		time.Sunday:    "日",
 		time.Monday:    "月",
@@ -1,12 +1,15 @@
 package service
 
 import (
+	"errors"
 	"testing"
 	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
 )
 
 func TestNewCalendarService(t *testing.T) {
-	service := NewCalendarService()
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
 
 	if service == nil {
 		t.Error("NewCalendarService should return a non-nil service")
@@ -14,8 +17,8 @@ func TestNewCalendarService(t *testing.T) {
 }
 
 func TestCalendarService_GetCalendar(t *testing.T) {
-	service := NewCalendarService()
-	calendar, err := service.GetCalendar(2025, 12)
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+	calendar, err := service.GetCalendar(2025, 12, domain.CalendarOptions{})
 
 	if err != nil {
 		t.Errorf("GetCalendar should not return error: %v", err)
@@ -53,11 +56,36 @@ func TestCalendarService_GetCalendar(t *testing.T) {
 		if day.Rokuyo == "" {
 			t.Error("Day should have a rokuyo")
 		}
+		if day.Lunar.Month < 1 || day.Lunar.Month > 12 || day.Lunar.Day < 1 || day.Lunar.Day > 30 {
+			t.Errorf("Day should have a valid lunar date, got %+v", day.Lunar)
+		}
+		if day.MoonPhase == "" {
+			t.Error("Day should have a moon phase")
+		}
+	}
+}
+
+func TestCalendarService_GetCalendar_LunarLeapMonth(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+
+	calendar, err := service.GetCalendar(2025, 7, domain.CalendarOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get calendar: %v", err)
+	}
+
+	// 2025年7月25日は旧暦閏6月1日
+	day := calendar.Days[24]
+	expected := domain.LunarDate{Year: 2025, Month: 6, Day: 1, IsLeapMonth: true}
+	if day.Lunar != expected {
+		t.Errorf("Expected %+v, got %+v", expected, day.Lunar)
+	}
+	if day.Rokuyo != "赤口" {
+		t.Errorf("Expected rokuyo 赤口, got %s", day.Rokuyo)
 	}
 }
 
 func TestCalendarService_GetHolidays(t *testing.T) {
-	service := NewCalendarService()
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
 	holidays := service.GetHolidays(2025)
 
 	if len(holidays) == 0 {
@@ -91,8 +119,44 @@ func TestCalendarService_GetHolidays(t *testing.T) {
 	}
 }
 
+func TestCalendarService_GetHolidaysBetween(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+
+	holidays, err := service.GetHolidaysBetween(
+		time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC),
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// 2026年の元日と成人の日（1月12日、範囲の終端を含む）
+	if len(holidays) != 2 {
+		t.Fatalf("Expected 2 holidays, got %d: %+v", len(holidays), holidays)
+	}
+	if holidays[0].Name != "元日" || holidays[1].Name != "成人の日" {
+		t.Errorf("Expected 元日 and 成人の日, got %s and %s", holidays[0].Name, holidays[1].Name)
+	}
+
+	invalid := []struct {
+		start, end time.Time
+		regions    []string
+	}{
+		{time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), nil},
+		{time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2010, 1, 2, 0, 0, 0, 0, time.UTC), nil},
+		{time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC), []string{"XX"}},
+		{time.Date(2300, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2300, 12, 31, 0, 0, 0, 0, time.UTC), nil},
+	}
+	for _, test := range invalid {
+		if _, err := service.GetHolidaysBetween(test.start, test.end, test.regions); err != domain.ErrInvalidInput {
+			t.Errorf("%v..%v %v: Expected ErrInvalidInput, got %v", test.start, test.end, test.regions, err)
+		}
+	}
+}
+
 func TestCalendarService_GetNthWeekday(t *testing.T) {
-	service := NewCalendarService()
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
 
 	// 2025年1月の第2月曜日（成人の日）
 	seijinNoHi := service.getNthWeekday(2025, 1, time.Monday, 2)
@@ -112,7 +176,7 @@ func TestCalendarService_GetNthWeekday(t *testing.T) {
 }
 
 func TestCalendarService_CalculateShunbun(t *testing.T) {
-	service := NewCalendarService()
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
 
 	// 2025年の春分の日
 	shunbun := service.calculateShunbun(2025)
@@ -128,7 +192,7 @@ func TestCalendarService_CalculateShunbun(t *testing.T) {
 }
 
 func TestCalendarService_CalculateShubun(t *testing.T) {
-	service := NewCalendarService()
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
 
 	// 2025年の秋分の日
 	shubun := service.calculateShubun(2025)
@@ -144,7 +208,7 @@ func TestCalendarService_CalculateShubun(t *testing.T) {
 }
 
 func TestCalendarService_CalculateRokuyo(t *testing.T) {
-	service := NewCalendarService()
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
 
 	date := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
 	rokuyo := service.calculateRokuyo(date)
@@ -163,8 +227,34 @@ func TestCalendarService_CalculateRokuyo(t *testing.T) {
 	}
 }
 
+func TestCalendarService_CalculateRokuyo_LunarCalendar(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+
+	tests := []struct {
+		name     string
+		date     time.Time
+		expected string
+	}{
+		// 旧暦1月1日は必ず先勝
+		{"2025年の旧正月", time.Date(2025, 1, 29, 0, 0, 0, 0, time.UTC), "先勝"},
+		{"2025年元日（旧暦12月2日）", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), "先勝"},
+		// 閏月も同じ月番号で数える（閏6月1日は赤口）
+		{"2025年 閏6月1日", time.Date(2025, 7, 25, 0, 0, 0, 0, time.UTC), "赤口"},
+		{"2025年 旧暦6月30日", time.Date(2025, 7, 24, 0, 0, 0, 0, time.UTC), "大安"},
+		{"2025年12月1日（旧暦10月12日）", time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC), "先負"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := service.calculateRokuyo(test.date); got != test.expected {
+				t.Errorf("Expected %s, got %s", test.expected, got)
+			}
+		})
+	}
+}
+
 func TestCalendarService_GetWeekdayJapanese(t *testing.T) {
-	service := NewCalendarService()
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
 
 	tests := []struct {
 		weekday  time.Weekday
@@ -188,10 +278,10 @@ func TestCalendarService_GetWeekdayJapanese(t *testing.T) {
 }
 
 func TestCalendarService_HolidayIntegrity(t *testing.T) {
-	service := NewCalendarService()
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
 
 	// 2025年のカレンダーと祝日を取得
-	calendar, err := service.GetCalendar(2025, 1)
+	calendar, err := service.GetCalendar(2025, 1, domain.CalendarOptions{})
 	if err != nil {
 		t.Fatalf("Failed to get calendar: %v", err)
 	}
@@ -212,3 +302,526 @@ func TestCalendarService_HolidayIntegrity(t *testing.T) {
 		t.Errorf("Unexpected number of holidays: %d", len(holidays))
 	}
 }
+
+// findHoliday は指定日の祝日名を返す
+func findHoliday(holidays []domain.Holiday, year, month, day int) (string, bool) {
+	target := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	for _, h := range holidays {
+		if h.Date.Equal(target) {
+			return h.Name, true
+		}
+	}
+	return "", false
+}
+
+func TestCalendarService_GetHolidays_SubstituteHolidays(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+
+	tests := []struct {
+		name  string
+		year  int
+		month int
+		day   int
+	}{
+		{"2025年 天皇誕生日（日）の振替", 2025, 2, 24},
+		{"2025年 みどりの日（日）の振替は5/6", 2025, 5, 6},
+		{"2025年 勤労感謝の日（日）の振替", 2025, 11, 24},
+		{"2020年 憲法記念日（日）の振替は5/6", 2020, 5, 6},
+		{"2024年 秋分の日（日）の振替", 2024, 9, 23},
+		{"2023年 元日（日）の振替", 2023, 1, 2},
+		{"2019年 文化の日（日）の振替", 2019, 11, 4},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			holidays := service.GetHolidays(test.year)
+			name, ok := findHoliday(holidays, test.year, test.month, test.day)
+			if !ok {
+				t.Fatalf("%d-%02d-%02d should be a holiday", test.year, test.month, test.day)
+			}
+			if name != "振替休日" {
+				t.Errorf("Expected '振替休日', got '%s'", name)
+			}
+		})
+	}
+}
+
+func TestCalendarService_GetHolidays_SubstituteHolidayBefore2007(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+
+	// 2006年は元日（日）の翌日が振替休日
+	holidays := service.GetHolidays(2006)
+	if name, ok := findHoliday(holidays, 2006, 1, 2); !ok || name != "振替休日" {
+		t.Errorf("2006-01-02 should be 振替休日, got '%s'", name)
+	}
+
+	// 振替休日の施行前（1973年4月12日以前）は振替が発生しない
+	holidays = service.GetHolidays(1972)
+	for _, h := range holidays {
+		if h.Name == "振替休日" {
+			t.Errorf("1972 should have no 振替休日, got %v", h.Date)
+		}
+	}
+}
+
+func TestCalendarService_GetHolidays_CitizensHolidays(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+
+	tests := []struct {
+		name  string
+		year  int
+		month int
+		day   int
+	}{
+		{"2015年 シルバーウィーク", 2015, 9, 22},
+		{"2026年 シルバーウィーク", 2026, 9, 22},
+		{"2032年 シルバーウィーク", 2032, 9, 21},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			holidays := service.GetHolidays(test.year)
+			name, ok := findHoliday(holidays, test.year, test.month, test.day)
+			if !ok {
+				t.Fatalf("%d-%02d-%02d should be a holiday", test.year, test.month, test.day)
+			}
+			if name != "国民の休日" {
+				t.Errorf("Expected '国民の休日', got '%s'", name)
+			}
+		})
+	}
+
+	// 2025年は敬老の日（9/15）と秋分の日（9/23）が離れているため国民の休日はない
+	for _, h := range service.GetHolidays(2025) {
+		if h.Name == "国民の休日" {
+			t.Errorf("2025 should have no 国民の休日, got %v", h.Date)
+		}
+	}
+}
+
+func TestCalendarService_GetHolidays_Sorted(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+	holidays := service.GetHolidays(2025)
+
+	for i := 1; i < len(holidays); i++ {
+		if holidays[i].Date.Before(holidays[i-1].Date) {
+			t.Errorf("Holidays should be sorted by date: %v before %v", holidays[i-1].Date, holidays[i].Date)
+		}
+	}
+}
+
+func TestCalendarService_GetCalendar_SubstituteHoliday(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+
+	calendar, err := service.GetCalendar(2025, 11, domain.CalendarOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get calendar: %v", err)
+	}
+
+	day := calendar.Days[23]
+	if !day.IsHoliday || day.Holiday != "振替休日" {
+		t.Errorf("2025-11-24 should be 振替休日, got is_holiday=%v holiday='%s'", day.IsHoliday, day.Holiday)
+	}
+}
+
+func TestCalendarService_GetCalendar_Grid(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+
+	tests := []struct {
+		weekStart time.Weekday
+		first     time.Time
+		last      time.Time
+	}{
+		// 2025年12月1日は月曜日、31日は水曜日
+		{time.Sunday, time.Date(2025, 11, 30, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{time.Monday, time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		calendar, err := service.GetCalendar(2025, 12, domain.CalendarOptions{Grid: true, WeekStart: test.weekStart})
+		if err != nil {
+			t.Fatalf("%s: GetCalendar should not return error: %v", test.weekStart, err)
+		}
+
+		if len(calendar.Days) != 31 {
+			t.Errorf("%s: Expected 31 days in December, got %d", test.weekStart, len(calendar.Days))
+		}
+		if len(calendar.Weeks) != 5 {
+			t.Fatalf("%s: Expected 5 weeks, got %d", test.weekStart, len(calendar.Weeks))
+		}
+
+		for _, week := range calendar.Weeks {
+			if len(week.Days) != 7 || week.Days[0].Date.Weekday() != test.weekStart {
+				t.Errorf("%s: Week should have 7 days starting on the week start, got %d days from %s",
+					test.weekStart, len(week.Days), week.Days[0].Date.Weekday())
+			}
+			for _, day := range week.Days {
+				if day.OutsideMonth != (day.Date.Month() != time.December) {
+					t.Errorf("%s: Unexpected outside_month %v for %s", test.weekStart, day.OutsideMonth, day.Date.Format("2006-01-02"))
+				}
+			}
+		}
+
+		firstWeek, lastWeek := calendar.Weeks[0], calendar.Weeks[len(calendar.Weeks)-1]
+		if !firstWeek.Days[0].Date.Equal(test.first) || !lastWeek.Days[6].Date.Equal(test.last) {
+			t.Errorf("%s: Expected grid %s - %s, got %s - %s", test.weekStart,
+				test.first.Format("2006-01-02"), test.last.Format("2006-01-02"),
+				firstWeek.Days[0].Date.Format("2006-01-02"), lastWeek.Days[6].Date.Format("2006-01-02"))
+		}
+		if firstWeek.ISOYear != 2025 || firstWeek.ISOWeek != 49 {
+			t.Errorf("%s: Expected first week 2025-W49, got %d-W%d", test.weekStart, firstWeek.ISOYear, firstWeek.ISOWeek)
+		}
+		if lastWeek.ISOYear != 2026 || lastWeek.ISOWeek != 1 {
+			t.Errorf("%s: Expected last week 2026-W01, got %d-W%d", test.weekStart, lastWeek.ISOYear, lastWeek.ISOWeek)
+		}
+
+		// 翌年の元日も祝日として表示される
+		for _, day := range lastWeek.Days {
+			if day.Date.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)) && day.Holiday != "元日" {
+				t.Errorf("%s: Expected 元日 on 2026-01-01, got %q", test.weekStart, day.Holiday)
+			}
+		}
+	}
+
+	if _, err := service.GetCalendar(2025, 12, domain.CalendarOptions{Grid: true, WeekStart: time.Wednesday}); err != domain.ErrInvalidInput {
+		t.Errorf("Expected ErrInvalidInput for week start on Wednesday, got %v", err)
+	}
+}
+
+func TestCalendarService_GetWeekCalendar(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+
+	tests := []struct {
+		year, week int
+		weekStart  time.Weekday
+		first      time.Time
+	}{
+		// 2025年の第1週は2024年12月30日（月）から
+		{2025, 1, time.Monday, time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC)},
+		{2025, 1, time.Sunday, time.Date(2024, 12, 29, 0, 0, 0, 0, time.UTC)},
+		{2025, 19, time.Monday, time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC)},
+		// 2026年は53週まである
+		{2026, 53, time.Monday, time.Date(2026, 12, 28, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		week, err := service.GetWeekCalendar(test.year, test.week, domain.CalendarOptions{WeekStart: test.weekStart})
+		if err != nil {
+			t.Fatalf("%d-W%d: GetWeekCalendar should not return error: %v", test.year, test.week, err)
+		}
+		if len(week.Days) != 7 {
+			t.Fatalf("%d-W%d: Expected 7 days, got %d", test.year, test.week, len(week.Days))
+		}
+		if !week.Days[0].Date.Equal(test.first) {
+			t.Errorf("%d-W%d (%s): Expected first day %s, got %s", test.year, test.week, test.weekStart,
+				test.first.Format("2006-01-02"), week.Days[0].Date.Format("2006-01-02"))
+		}
+		if week.ISOYear != test.year || week.ISOWeek != test.week {
+			t.Errorf("Expected %d-W%d, got %d-W%d", test.year, test.week, week.ISOYear, week.ISOWeek)
+		}
+	}
+
+	// 2025年5月5日（こどもの日）と6日（振替休日）
+	week, _ := service.GetWeekCalendar(2025, 19, domain.CalendarOptions{WeekStart: time.Monday})
+	if week.Days[0].Holiday != "こどもの日" || week.Days[1].Holiday != "振替休日" {
+		t.Errorf("Expected こどもの日 and 振替休日, got %q and %q", week.Days[0].Holiday, week.Days[1].Holiday)
+	}
+
+	if _, err := service.GetWeekCalendar(2025, 53, domain.CalendarOptions{}); err != domain.ErrInvalidInput {
+		t.Errorf("Expected ErrInvalidInput for 2025-W53, got %v", err)
+	}
+}
+
+func TestCalendarService_GetDayCalendar(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+
+	day, err := service.GetDayCalendar(2025, 11, 3, domain.CalendarOptions{})
+	if err != nil {
+		t.Fatalf("GetDayCalendar should not return error: %v", err)
+	}
+	if day.Day != 3 || day.Holiday != "文化の日" || day.Rokuyo == "" {
+		t.Errorf("Unexpected day: %+v", day)
+	}
+
+	if _, err := service.GetDayCalendar(2025, 2, 29, domain.CalendarOptions{}); err != domain.ErrInvalidInput {
+		t.Errorf("Expected ErrInvalidInput for 2025-02-29, got %v", err)
+	}
+}
+
+func TestCalendarService_GetYearCalendar(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+
+	year, err := service.GetYearCalendar(2025, domain.CalendarOptions{})
+	if err != nil {
+		t.Fatalf("GetYearCalendar should not return error: %v", err)
+	}
+	if len(year.Months) != 12 {
+		t.Fatalf("Expected 12 months, got %d", len(year.Months))
+	}
+
+	holidays := 0
+	for i, month := range year.Months {
+		expected, _ := service.GetCalendar(2025, i+1, domain.CalendarOptions{})
+		if len(month.Days) != len(expected.Days) {
+			t.Errorf("Month %d: Expected %d days, got %d", i+1, len(expected.Days), len(month.Days))
+			continue
+		}
+		for j, day := range month.Days {
+			if !day.Date.Equal(expected.Days[j].Date) || day.Holiday != expected.Days[j].Holiday || day.Rokuyo != expected.Days[j].Rokuyo {
+				t.Errorf("%s: Year view differs from month view", day.Date.Format("2006-01-02"))
+			}
+			if day.IsHoliday {
+				holidays++
+			}
+		}
+	}
+
+	// 2025年の祝日・休日は振替休日を含めて19日
+	if holidays != 19 {
+		t.Errorf("Expected 19 holidays in 2025, got %d", holidays)
+	}
+}
+
+func TestCalendarService_GetCalendar_Events(t *testing.T) {
+	var gotStart, gotEnd time.Time
+	eventRepo := &MockEventRepository{
+		GetByDateRangeFunc: func(start, end time.Time) ([]domain.Event, error) {
+			gotStart, gotEnd = start, end
+			return []domain.Event{
+				{
+					ID:        1,
+					Title:     "会議",
+					StartDate: time.Date(2025, 12, 10, 10, 0, 0, 0, time.UTC),
+					EndDate:   time.Date(2025, 12, 10, 11, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:        2,
+					Title:     "出張",
+					StartDate: time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC),
+					EndDate:   time.Date(2025, 12, 17, 0, 0, 0, 0, time.UTC),
+					AllDay:    true,
+				},
+				{
+					// 前月から続き翌月に終わるイベント
+					ID:        3,
+					Title:     "年末年始休暇",
+					StartDate: time.Date(2025, 11, 29, 0, 0, 0, 0, time.UTC),
+					EndDate:   time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC),
+					AllDay:    true,
+				},
+				{
+					// 0時ちょうどに終わるイベントは前日まで
+					ID:        4,
+					Title:     "忘年会",
+					StartDate: time.Date(2025, 12, 26, 19, 0, 0, 0, time.UTC),
+					EndDate:   time.Date(2025, 12, 27, 0, 0, 0, 0, time.UTC),
+				},
+			}, nil
+		},
+	}
+	service := NewCalendarService(&MockCustomHolidayRepository{}, eventRepo)
+
+	calendar, err := service.GetCalendar(2025, 12, domain.CalendarOptions{})
+	if err != nil {
+		t.Fatalf("GetCalendar should not return error: %v", err)
+	}
+
+	// タイムゾーンによるずれを見込んで前後1日を含めて取得する
+	if !gotStart.Equal(time.Date(2025, 11, 30, 0, 0, 0, 0, time.UTC)) || !gotEnd.Equal(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected range %s - %s", gotStart, gotEnd)
+	}
+
+	positions := func(day int) map[int]string {
+		result := map[int]string{}
+		for _, event := range calendar.Days[day-1].Events {
+			result[event.ID] = event.Position
+		}
+		return result
+	}
+
+	tests := []struct {
+		day      int
+		expected map[int]string
+	}{
+		{1, map[int]string{3: domain.EventPositionMiddle}},
+		{10, map[int]string{1: domain.EventPositionSingle, 3: domain.EventPositionMiddle}},
+		{15, map[int]string{2: domain.EventPositionStart, 3: domain.EventPositionMiddle}},
+		{16, map[int]string{2: domain.EventPositionMiddle, 3: domain.EventPositionMiddle}},
+		{17, map[int]string{2: domain.EventPositionEnd, 3: domain.EventPositionMiddle}},
+		{26, map[int]string{3: domain.EventPositionMiddle, 4: domain.EventPositionSingle}},
+		{27, map[int]string{3: domain.EventPositionMiddle}},
+	}
+
+	for _, test := range tests {
+		got := positions(test.day)
+		if len(got) != len(test.expected) {
+			t.Errorf("12/%d: Expected events %v, got %v", test.day, test.expected, got)
+			continue
+		}
+		for id, position := range test.expected {
+			if got[id] != position {
+				t.Errorf("12/%d: Expected event %d at %s, got %q", test.day, id, position, got[id])
+			}
+		}
+	}
+
+	// グリッド表示では翌月の日付にも続きのイベントが置かれる
+	grid, err := service.GetCalendar(2025, 12, domain.CalendarOptions{Grid: true})
+	if err != nil {
+		t.Fatalf("GetCalendar should not return error: %v", err)
+	}
+	lastWeek := grid.Weeks[len(grid.Weeks)-1]
+	for _, day := range lastWeek.Days {
+		if day.Date.Equal(time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)) {
+			if len(day.Events) != 1 || day.Events[0].Position != domain.EventPositionMiddle {
+				t.Errorf("Expected continuing event on 2026-01-03, got %+v", day.Events)
+			}
+		}
+	}
+}
+
+func TestCalendarService_GetCalendar_RecurringEvents(t *testing.T) {
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	eventRepo := &MockEventRepository{
+		GetByDateRangeFunc: func(start, end time.Time) ([]domain.Event, error) {
+			// 前月に始まった毎週月曜日の定例会（12月15日の回は除く）
+			return []domain.Event{{
+				ID:        1,
+				Title:     "定例会",
+				StartDate: time.Date(2025, 11, 3, 10, 0, 0, 0, tokyo),
+				EndDate:   time.Date(2025, 11, 3, 11, 0, 0, 0, tokyo),
+				TimeZone:  "Asia/Tokyo",
+				RRule:     "FREQ=WEEKLY;BYDAY=MO",
+				ExDates:   []time.Time{time.Date(2025, 12, 15, 10, 0, 0, 0, tokyo)},
+			}}, nil
+		},
+	}
+	service := NewCalendarService(&MockCustomHolidayRepository{}, eventRepo)
+
+	calendar, err := service.GetCalendar(2025, 12, domain.CalendarOptions{})
+	if err != nil {
+		t.Fatalf("GetCalendar returned error: %v", err)
+	}
+
+	days := []int{}
+	for _, day := range calendar.Days {
+		if len(day.Events) > 0 {
+			days = append(days, day.Day)
+			if day.Events[0].RecurrenceID == nil || day.Events[0].Position != domain.EventPositionSingle {
+				t.Errorf("Day %d: Unexpected event %+v", day.Day, day.Events[0])
+			}
+		}
+	}
+	expected := []int{1, 8, 22, 29}
+	if len(days) != len(expected) {
+		t.Fatalf("Expected events on %v, got %v", expected, days)
+	}
+	for i := range days {
+		if days[i] != expected[i] {
+			t.Errorf("Expected events on %v, got %v", expected, days)
+			break
+		}
+	}
+}
+
+func TestCalendarService_GetCalendar_EventsError(t *testing.T) {
+	eventRepo := &MockEventRepository{
+		GetByDateRangeFunc: func(start, end time.Time) ([]domain.Event, error) {
+			return nil, errors.New("database error")
+		},
+	}
+	service := NewCalendarService(&MockCustomHolidayRepository{}, eventRepo)
+
+	if _, err := service.GetCalendar(2025, 12, domain.CalendarOptions{}); err == nil {
+		t.Error("GetCalendar should return error when events cannot be loaded")
+	}
+}
+
+func TestCalendarService_UnsupportedYear(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+
+	for _, year := range []int{1, 1600, 1677, domain.MinSupportedYear - 1, domain.MaxSupportedYear + 1, 2263, 3000} {
+		if _, err := service.GetCalendar(year, 1, domain.CalendarOptions{}); err != domain.ErrInvalidInput {
+			t.Errorf("GetCalendar(%d): Expected ErrInvalidInput, got %v", year, err)
+		}
+		if _, err := service.GetWeekCalendar(year, 1, domain.CalendarOptions{}); err != domain.ErrInvalidInput {
+			t.Errorf("GetWeekCalendar(%d): Expected ErrInvalidInput, got %v", year, err)
+		}
+		if _, err := service.GetDayCalendar(year, 1, 1, domain.CalendarOptions{}); err != domain.ErrInvalidInput {
+			t.Errorf("GetDayCalendar(%d): Expected ErrInvalidInput, got %v", year, err)
+		}
+		if _, err := service.GetYearCalendar(year, domain.CalendarOptions{}); err != domain.ErrInvalidInput {
+			t.Errorf("GetYearCalendar(%d): Expected ErrInvalidInput, got %v", year, err)
+		}
+	}
+
+	for _, year := range []int{domain.MinSupportedYear, domain.MaxSupportedYear} {
+		if _, err := service.GetCalendar(year, 1, domain.CalendarOptions{Grid: true}); err != nil {
+			t.Errorf("GetCalendar(%d): unexpected error: %v", year, err)
+		}
+	}
+}
+
+func TestCalendarService_GetCalendar_TimeZone(t *testing.T) {
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	newYork, _ := time.LoadLocation("America/New_York")
+
+	eventRepo := &MockEventRepository{
+		GetByDateRangeFunc: func(start, end time.Time) ([]domain.Event, error) {
+			return []domain.Event{
+				{
+					// 日本時間12月11日7時（UTCでは12月10日22時）
+					ID:        1,
+					Title:     "朝会",
+					StartDate: time.Date(2025, 12, 10, 22, 0, 0, 0, time.UTC),
+					EndDate:   time.Date(2025, 12, 10, 22, 30, 0, 0, time.UTC),
+					TimeZone:  "Asia/Tokyo",
+				},
+				{
+					// 終日イベントはどのタイムゾーンで見ても同じ日付
+					ID:        2,
+					Title:     "創立記念日",
+					StartDate: time.Date(2025, 12, 19, 15, 0, 0, 0, time.UTC),
+					EndDate:   time.Date(2025, 12, 19, 15, 0, 0, 0, time.UTC),
+					AllDay:    true,
+					TimeZone:  "Asia/Tokyo",
+				},
+			}, nil
+		},
+	}
+	service := NewCalendarService(&MockCustomHolidayRepository{}, eventRepo)
+
+	tests := []struct {
+		loc      *time.Location
+		morning  int
+		allDay   int
+		location *time.Location
+	}{
+		{nil, 11, 20, time.UTC},
+		{tokyo, 11, 20, tokyo},
+		{time.UTC, 10, 20, time.UTC},
+		{newYork, 10, 20, newYork},
+	}
+
+	for _, test := range tests {
+		calendar, err := service.GetCalendar(2025, 12, domain.CalendarOptions{Location: test.loc})
+		if err != nil {
+			t.Fatalf("GetCalendar should not return error: %v", err)
+		}
+
+		found := map[int]int{}
+		for _, day := range calendar.Days {
+			for _, event := range day.Events {
+				found[event.ID] = day.Day
+			}
+		}
+		if found[1] != test.morning || found[2] != test.allDay {
+			t.Errorf("%v: Expected events on %d and %d, got %v", test.loc, test.morning, test.allDay, found)
+		}
+
+		first := calendar.Days[0].Date
+		if first.Location().String() != test.location.String() || first.Day() != 1 || first.Hour() != 0 {
+			t.Errorf("%v: Expected first day at midnight in %s, got %s", test.loc, test.location, first)
+		}
+	}
+}
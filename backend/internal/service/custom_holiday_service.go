@@ -0,0 +1,148 @@
+package service
+
+import (
+	"sort"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+type CustomHolidayService struct {
+	repo CustomHolidayRepositoryInterface
+}
+
+type CustomHolidayRepositoryInterface interface {
+	GetAll() ([]domain.CustomHoliday, error)
+	GetByDateRange(start, end time.Time) ([]domain.CustomHoliday, error)
+	GetByID(id int) (*domain.CustomHoliday, error)
+	Create(holiday *domain.CustomHoliday) error
+	Update(holiday *domain.CustomHoliday) error
+	Delete(id int) error
+}
+
+func NewCustomHolidayService(repo CustomHolidayRepositoryInterface) *CustomHolidayService {
+	return &CustomHolidayService{repo: repo}
+}
+
+func (s *CustomHolidayService) GetAllCustomHolidays() ([]domain.CustomHoliday, error) {
+	return s.repo.GetAll()
+}
+
+func (s *CustomHolidayService) GetCustomHolidayByID(id int) (*domain.CustomHoliday, error) {
+	return s.repo.GetByID(id)
+}
+
+func (s *CustomHolidayService) CreateCustomHoliday(holiday *domain.CustomHoliday) error {
+	if err := validateCustomHoliday(holiday); err != nil {
+		return err
+	}
+
+	return s.repo.Create(holiday)
+}
+
+func (s *CustomHolidayService) UpdateCustomHoliday(holiday *domain.CustomHoliday) error {
+	if err := validateCustomHoliday(holiday); err != nil {
+		return err
+	}
+
+	existing, err := s.repo.GetByID(holiday.ID)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return domain.ErrNotFound
+	}
+
+	return s.repo.Update(holiday)
+}
+
+func (s *CustomHolidayService) DeleteCustomHoliday(id int) error {
+	existing, err := s.repo.GetByID(id)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return domain.ErrNotFound
+	}
+
+	return s.repo.Delete(id)
+}
+
+// validateCustomHoliday 独自休日のバリデーションと日付の正規化
+// 開始日・終了日は時刻を切り捨てた日付として扱い、毎年繰り返す休日の期間は1年未満とする
+func validateCustomHoliday(holiday *domain.CustomHoliday) error {
+	if holiday.Name == "" {
+		return domain.ErrInvalidInput
+	}
+	if holiday.StartDate.IsZero() || holiday.EndDate.IsZero() {
+		return domain.ErrInvalidInput
+	}
+
+	holiday.StartDate = truncateToDate(holiday.StartDate)
+	holiday.EndDate = truncateToDate(holiday.EndDate)
+
+	if holiday.EndDate.Before(holiday.StartDate) {
+		return domain.ErrInvalidInput
+	}
+	if holiday.Recurring && !holiday.EndDate.Before(holiday.StartDate.AddDate(1, 0, 0)) {
+		return domain.ErrInvalidInput
+	}
+
+	return nil
+}
+
+// truncateToDate 時刻を切り捨てて UTC の日付にする
+func truncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// expandCustomHolidays 独自休日を期間内の1日ごとの休日に展開する
+// 毎年繰り返す休日は開始日の年以降の各年について展開し、年をまたぐ期間（12/29〜1/3など）にも対応する
+func expandCustomHolidays(customs []domain.CustomHoliday, start, end time.Time) []domain.Holiday {
+	holidays := []domain.Holiday{}
+
+	for _, c := range customs {
+		first := truncateToDate(c.StartDate)
+		last := truncateToDate(c.EndDate)
+
+		if !c.Recurring {
+			holidays = appendCustomDays(holidays, c.Name, first, last, start, end)
+			continue
+		}
+
+		days := int(last.Sub(first).Hours() / 24)
+		for year := start.Year() - 1; year <= end.Year(); year++ {
+			if year < first.Year() {
+				continue
+			}
+			from := time.Date(year, first.Month(), first.Day(), 0, 0, 0, 0, time.UTC)
+			if from.Day() != first.Day() {
+				// 2月29日始まりの休日は平年には設けない
+				continue
+			}
+			holidays = appendCustomDays(holidays, c.Name, from, from.AddDate(0, 0, days), start, end)
+		}
+	}
+
+	sort.SliceStable(holidays, func(i, j int) bool {
+		return holidays[i].Date.Before(holidays[j].Date)
+	})
+
+	return holidays
+}
+
+// appendCustomDays first から last までのうち、start から end の期間に含まれる日を追加
+func appendCustomDays(holidays []domain.Holiday, name string, first, last, start, end time.Time) []domain.Holiday {
+	if first.Before(start) {
+		first = start
+	}
+	if last.After(end) {
+		last = end
+	}
+
+	for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+		holidays = append(holidays, domain.Holiday{Date: d, Name: name, Type: domain.HolidayTypeCustom})
+	}
+
+	return holidays
+}
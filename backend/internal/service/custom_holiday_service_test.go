@@ -0,0 +1,308 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// MockCustomHolidayRepository はテスト用のモックリポジトリ
+type MockCustomHolidayRepository struct {
+	GetAllFunc         func() ([]domain.CustomHoliday, error)
+	GetByDateRangeFunc func(start, end time.Time) ([]domain.CustomHoliday, error)
+	GetByIDFunc        func(id int) (*domain.CustomHoliday, error)
+	CreateFunc         func(holiday *domain.CustomHoliday) error
+	UpdateFunc         func(holiday *domain.CustomHoliday) error
+	DeleteFunc         func(id int) error
+}
+
+func (m *MockCustomHolidayRepository) GetAll() ([]domain.CustomHoliday, error) {
+	if m.GetAllFunc != nil {
+		return m.GetAllFunc()
+	}
+	return []domain.CustomHoliday{}, nil
+}
+
+func (m *MockCustomHolidayRepository) GetByDateRange(start, end time.Time) ([]domain.CustomHoliday, error) {
+	if m.GetByDateRangeFunc != nil {
+		return m.GetByDateRangeFunc(start, end)
+	}
+	return []domain.CustomHoliday{}, nil
+}
+
+func (m *MockCustomHolidayRepository) GetByID(id int) (*domain.CustomHoliday, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(id)
+	}
+	return nil, nil
+}
+
+func (m *MockCustomHolidayRepository) Create(holiday *domain.CustomHoliday) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(holiday)
+	}
+	return nil
+}
+
+func (m *MockCustomHolidayRepository) Update(holiday *domain.CustomHoliday) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(holiday)
+	}
+	return nil
+}
+
+func (m *MockCustomHolidayRepository) Delete(id int) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(id)
+	}
+	return nil
+}
+
+// companyHolidays テスト用の独自休日
+var companyHolidays = []domain.CustomHoliday{
+	{
+		ID:        1,
+		Name:      "創立記念日",
+		StartDate: time.Date(2010, 6, 15, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2010, 6, 15, 0, 0, 0, 0, time.UTC),
+		Recurring: true,
+	},
+	{
+		ID:        2,
+		Name:      "年末年始休業",
+		StartDate: time.Date(2020, 12, 29, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+		Recurring: true,
+	},
+	{
+		ID:        3,
+		Name:      "お盆休み",
+		StartDate: time.Date(2025, 8, 13, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, 8, 16, 0, 0, 0, 0, time.UTC),
+	},
+}
+
+func TestNewCustomHolidayService(t *testing.T) {
+	service := NewCustomHolidayService(&MockCustomHolidayRepository{})
+
+	if service == nil {
+		t.Error("NewCustomHolidayService should return a non-nil service")
+	}
+}
+
+func TestCustomHolidayService_CreateCustomHoliday(t *testing.T) {
+	var created *domain.CustomHoliday
+	repo := &MockCustomHolidayRepository{
+		CreateFunc: func(holiday *domain.CustomHoliday) error {
+			created = holiday
+			holiday.ID = 1
+			return nil
+		},
+	}
+	service := NewCustomHolidayService(repo)
+
+	holiday := &domain.CustomHoliday{
+		Name:      "創立記念日",
+		StartDate: time.Date(2025, 6, 15, 10, 30, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, 6, 15, 18, 0, 0, 0, time.UTC),
+		Recurring: true,
+	}
+
+	if err := service.CreateCustomHoliday(holiday); err != nil {
+		t.Fatalf("CreateCustomHoliday should not return error: %v", err)
+	}
+	if created == nil || created.ID != 1 {
+		t.Fatal("Expected repository Create to be called")
+	}
+	if !created.StartDate.Equal(time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected start date to be truncated, got %v", created.StartDate)
+	}
+}
+
+func TestCustomHolidayService_CreateCustomHoliday_Validation(t *testing.T) {
+	service := NewCustomHolidayService(&MockCustomHolidayRepository{})
+	start := time.Date(2025, 12, 29, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		holiday domain.CustomHoliday
+	}{
+		{"empty name", domain.CustomHoliday{StartDate: start, EndDate: start}},
+		{"missing dates", domain.CustomHoliday{Name: "休業"}},
+		{"end before start", domain.CustomHoliday{Name: "休業", StartDate: start, EndDate: start.AddDate(0, 0, -1)}},
+		{"recurring over a year", domain.CustomHoliday{Name: "休業", StartDate: start, EndDate: start.AddDate(1, 0, 0), Recurring: true}},
+	}
+
+	for _, test := range tests {
+		holiday := test.holiday
+		if err := service.CreateCustomHoliday(&holiday); err != domain.ErrInvalidInput {
+			t.Errorf("%s: Expected ErrInvalidInput, got %v", test.name, err)
+		}
+	}
+}
+
+func TestCustomHolidayService_UpdateCustomHoliday_NotFound(t *testing.T) {
+	service := NewCustomHolidayService(&MockCustomHolidayRepository{})
+	holiday := &domain.CustomHoliday{
+		ID:        99,
+		Name:      "創立記念日",
+		StartDate: time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC),
+	}
+
+	if err := service.UpdateCustomHoliday(holiday); err != domain.ErrNotFound {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestCustomHolidayService_DeleteCustomHoliday(t *testing.T) {
+	deleted := 0
+	repo := &MockCustomHolidayRepository{
+		GetByIDFunc: func(id int) (*domain.CustomHoliday, error) {
+			if id == 1 {
+				return &companyHolidays[0], nil
+			}
+			return nil, nil
+		},
+		DeleteFunc: func(id int) error {
+			deleted = id
+			return nil
+		},
+	}
+	service := NewCustomHolidayService(repo)
+
+	if err := service.DeleteCustomHoliday(1); err != nil {
+		t.Errorf("DeleteCustomHoliday should not return error: %v", err)
+	}
+	if deleted != 1 {
+		t.Errorf("Expected id 1 to be deleted, got %d", deleted)
+	}
+	if err := service.DeleteCustomHoliday(2); err != domain.ErrNotFound {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestExpandCustomHolidays(t *testing.T) {
+	tests := []struct {
+		name     string
+		start    time.Time
+		end      time.Time
+		expected []string
+	}{
+		{
+			// 年をまたぐ休業期間は前年分から続けて展開される
+			name:     "new year",
+			start:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+			expected: []string{"2025-01-01", "2025-01-02", "2025-01-03"},
+		},
+		{
+			name:     "year end",
+			start:    time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC),
+			expected: []string{"2025-12-29", "2025-12-30", "2025-12-31"},
+		},
+		{
+			name:     "recurring anniversary and one-off closure",
+			start:    time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2025, 8, 31, 0, 0, 0, 0, time.UTC),
+			expected: []string{"2025-06-15", "2025-08-13", "2025-08-14", "2025-08-15", "2025-08-16"},
+		},
+		{
+			// 単発の休業は他の年には展開されない
+			name:     "one-off closure in another year",
+			start:    time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2026, 8, 31, 0, 0, 0, 0, time.UTC),
+			expected: []string{},
+		},
+		{
+			// 繰り返しは開始日の年から
+			name:     "before first year",
+			start:    time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			end:      time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC),
+			expected: []string{},
+		},
+	}
+
+	for _, test := range tests {
+		holidays := expandCustomHolidays(companyHolidays, test.start, test.end)
+		if len(holidays) != len(test.expected) {
+			t.Errorf("%s: Expected %d days, got %d", test.name, len(test.expected), len(holidays))
+			continue
+		}
+		for i, h := range holidays {
+			if h.Date.Format("2006-01-02") != test.expected[i] {
+				t.Errorf("%s: Expected %s, got %s", test.name, test.expected[i], h.Date.Format("2006-01-02"))
+			}
+			if h.Type != domain.HolidayTypeCustom {
+				t.Errorf("%s: Expected type %q, got %q", test.name, domain.HolidayTypeCustom, h.Type)
+			}
+		}
+	}
+}
+
+func TestCalendarService_GetCalendar_CustomHolidays(t *testing.T) {
+	repo := &MockCustomHolidayRepository{
+		GetByDateRangeFunc: func(start, end time.Time) ([]domain.CustomHoliday, error) {
+			return companyHolidays, nil
+		},
+	}
+	service := NewCalendarService(repo, &MockEventRepository{})
+
+	calendar, err := service.GetCalendar(2026, 1, domain.CalendarOptions{})
+	if err != nil {
+		t.Fatalf("GetCalendar should not return error: %v", err)
+	}
+
+	// 1月1日は元日と年末年始休業の両方
+	newYear := calendar.Days[0]
+	if !newYear.IsHoliday || newYear.Holiday != "元日" {
+		t.Errorf("Expected 2026-01-01 to be 元日, got %q", newYear.Holiday)
+	}
+	if len(newYear.Holidays) != 2 || newYear.Holidays[0].Type != domain.HolidayTypeNational || newYear.Holidays[1].Type != domain.HolidayTypeCustom {
+		t.Errorf("Expected national and custom holidays on 2026-01-01, got %v", newYear.Holidays)
+	}
+
+	// 1月2日は独自休日のみ
+	jan2 := calendar.Days[1]
+	if !jan2.IsHoliday || jan2.Holiday != "年末年始休業" {
+		t.Errorf("Expected 2026-01-02 to be 年末年始休業, got %q", jan2.Holiday)
+	}
+
+	if calendar.Days[4].IsHoliday {
+		t.Errorf("Expected 2026-01-05 not to be a holiday, got %v", calendar.Days[4].Holidays)
+	}
+}
+
+func TestCalendarService_GetCalendar_CustomHolidaysError(t *testing.T) {
+	repo := &MockCustomHolidayRepository{
+		GetByDateRangeFunc: func(start, end time.Time) ([]domain.CustomHoliday, error) {
+			return nil, errors.New("database error")
+		},
+	}
+	service := NewCalendarService(repo, &MockEventRepository{})
+
+	if _, err := service.GetCalendar(2026, 1, domain.CalendarOptions{}); err == nil {
+		t.Error("Expected error when repository fails")
+	}
+}
+
+func TestCalendarService_GetCustomHolidays_DateRange(t *testing.T) {
+	var from, to time.Time
+	repo := &MockCustomHolidayRepository{
+		GetByDateRangeFunc: func(start, end time.Time) ([]domain.CustomHoliday, error) {
+			from, to = start, end
+			return companyHolidays, nil
+		},
+	}
+	service := NewCalendarService(repo, &MockEventRepository{})
+
+	if _, err := service.GetCalendar(2026, 1, domain.CalendarOptions{}); err != nil {
+		t.Fatalf("Failed to get calendar: %v", err)
+	}
+	if !from.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)) || !to.Equal(time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected custom holidays to be loaded for 2026-01-01..2026-01-31, got %v..%v", from, to)
+	}
+}
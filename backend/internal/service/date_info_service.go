@@ -0,0 +1,56 @@
+package service
+
+import (
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// maxDateInfoDates 一度に問い合わせできる日付の最大数
+const maxDateInfoDates = 1000
+
+type DateInfoService struct {
+	businessDays *BusinessDayService
+}
+
+func NewDateInfoService(calendar HolidayCalendarInterface) *DateInfoService {
+	return &DateInfoService{businessDays: NewBusinessDayService(calendar)}
+}
+
+// GetDateInfo 各日付の曜日・六曜・祝日・営業日かどうかを指定された順に求める
+// 祝日と休業日の判定は営業日計算と同じオプションに従う
+func (s *DateInfoService) GetDateInfo(dates []time.Time, opts domain.BusinessDayOptions, lang string) ([]domain.DateInfo, error) {
+	if len(dates) == 0 || len(dates) > maxDateInfoDates || !supportedLanguage(lang) {
+		return nil, domain.ErrInvalidInput
+	}
+
+	bc, err := s.businessDays.newBusinessCalendar(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]domain.DateInfo, 0, len(dates))
+	for _, date := range dates {
+		date = truncateToDate(date)
+		if err := validateYear(date.Year()); err != nil {
+			return nil, err
+		}
+		holidays, err := bc.holidaysOn(date)
+		if err != nil {
+			return nil, err
+		}
+		isWeekend := bc.weekend[date.Weekday()]
+
+		infos = append(infos, domain.DateInfo{
+			Date:          date,
+			Weekday:       localizeWeekday(date.Weekday(), lang),
+			Rokuyo:        localizeRokuyo(rokuyoOf(toLunarDate(date)), lang),
+			IsHoliday:     len(holidays) > 0,
+			Holidays:      localizeHolidays(holidays, lang),
+			IsWeekend:     isWeekend,
+			IsBusinessDay: !isWeekend && len(holidays) == 0,
+		})
+	}
+
+	return infos, nil
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+func TestDateInfoService_GetDateInfo(t *testing.T) {
+	repo := &MockCustomHolidayRepository{
+		GetByDateRangeFunc: func(start, end time.Time) ([]domain.CustomHoliday, error) {
+			return []domain.CustomHoliday{
+				{
+					ID:        1,
+					Name:      "年末年始休業",
+					StartDate: time.Date(2020, 12, 29, 0, 0, 0, 0, time.UTC),
+					EndDate:   time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+					Recurring: true,
+				},
+			}, nil
+		},
+	}
+	service := NewDateInfoService(NewCalendarService(repo, &MockEventRepository{}))
+
+	dates := []time.Time{
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 4, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 12, 29, 0, 0, 0, 0, time.UTC),
+	}
+	infos, err := service.GetDateInfo(dates, domain.BusinessDayOptions{IncludeCustom: true}, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(infos) != len(dates) {
+		t.Fatalf("Expected %d results, got %d", len(dates), len(infos))
+	}
+
+	tests := []struct {
+		weekday  string
+		holiday  bool
+		weekend  bool
+		business bool
+	}{
+		{"水", true, false, false},
+		{"土", false, true, false},
+		{"月", false, false, true},
+		{"月", true, false, false},
+	}
+	for i, test := range tests {
+		info := infos[i]
+		if !info.Date.Equal(dates[i]) {
+			t.Errorf("%d: Expected date %v, got %v", i, dates[i], info.Date)
+		}
+		if info.Weekday != test.weekday || info.IsHoliday != test.holiday || info.IsWeekend != test.weekend || info.IsBusinessDay != test.business {
+			t.Errorf("%v: Expected weekday=%s holiday=%v weekend=%v business=%v, got %+v",
+				dates[i].Format("2006-01-02"), test.weekday, test.holiday, test.weekend, test.business, info)
+		}
+		if info.Rokuyo == "" {
+			t.Errorf("%v: Expected rokuyo to be set", dates[i].Format("2006-01-02"))
+		}
+	}
+	if infos[0].Holidays[0].Name != "元日" {
+		t.Errorf("Expected 元日, got %+v", infos[0].Holidays)
+	}
+	if infos[3].Holidays[0].Name != "年末年始休業" {
+		t.Errorf("Expected 年末年始休業, got %+v", infos[3].Holidays)
+	}
+}
+
+func TestDateInfoService_GetDateInfo_English(t *testing.T) {
+	service := NewDateInfoService(NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{}))
+
+	infos, err := service.GetDateInfo([]time.Time{time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}, domain.BusinessDayOptions{}, domain.LanguageEnglish)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if infos[0].Weekday != "Wed" {
+		t.Errorf("Expected Wed, got %s", infos[0].Weekday)
+	}
+	if infos[0].Holidays[0].Name != "New Year's Day" {
+		t.Errorf("Expected New Year's Day, got %s", infos[0].Holidays[0].Name)
+	}
+	if _, ok := rokuyoNamesRomaji[infos[0].Rokuyo]; ok {
+		t.Errorf("Expected romanized rokuyo, got %s", infos[0].Rokuyo)
+	}
+}
+
+func TestDateInfoService_GetDateInfo_InvalidInput(t *testing.T) {
+	service := NewDateInfoService(NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{}))
+	date := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if _, err := service.GetDateInfo(nil, domain.BusinessDayOptions{}, ""); err != domain.ErrInvalidInput {
+		t.Errorf("Expected ErrInvalidInput for no dates, got %v", err)
+	}
+	if _, err := service.GetDateInfo(make([]time.Time, maxDateInfoDates+1), domain.BusinessDayOptions{}, ""); err != domain.ErrInvalidInput {
+		t.Errorf("Expected ErrInvalidInput for too many dates, got %v", err)
+	}
+	if _, err := service.GetDateInfo([]time.Time{date}, domain.BusinessDayOptions{}, "fr"); err != domain.ErrInvalidInput {
+		t.Errorf("Expected ErrInvalidInput for unsupported language, got %v", err)
+	}
+	if _, err := service.GetDateInfo([]time.Time{date}, domain.BusinessDayOptions{Regions: []string{"XX"}}, ""); err != domain.ErrInvalidInput {
+		t.Errorf("Expected ErrInvalidInput for unknown region, got %v", err)
+	}
+	if _, err := service.GetDateInfo([]time.Time{date, time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)}, domain.BusinessDayOptions{}, ""); err != domain.ErrInvalidInput {
+		t.Errorf("Expected ErrInvalidInput for unsupported year, got %v", err)
+	}
+}
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// eras 元号の一覧（新しい順）
+// グレゴリオ暦が施行された1873年（明治6年）1月1日以降を対象とする
+var eras = []domain.Era{
+	{Name: "令和", Romaji: "Reiwa", StartDate: time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC)},
+	{Name: "平成", Romaji: "Heisei", StartDate: time.Date(1989, 1, 8, 0, 0, 0, 0, time.UTC), EndDate: eraEnd(2019, 4, 30)},
+	{Name: "昭和", Romaji: "Showa", StartDate: time.Date(1926, 12, 25, 0, 0, 0, 0, time.UTC), EndDate: eraEnd(1989, 1, 7)},
+	{Name: "大正", Romaji: "Taisho", StartDate: time.Date(1912, 7, 30, 0, 0, 0, 0, time.UTC), EndDate: eraEnd(1926, 12, 24)},
+	{Name: "明治", Romaji: "Meiji", StartDate: time.Date(1868, 1, 1, 0, 0, 0, 0, time.UTC), EndDate: eraEnd(1912, 7, 29)},
+}
+
+// eraEnd 元号の最終日を作成
+func eraEnd(year int, month time.Month, day int) *time.Time {
+	end := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return &end
+}
+
+// gregorianAdoption 日本でグレゴリオ暦が施行された日（これより前は旧暦のため変換対象外）
+var gregorianAdoption = time.Date(1873, 1, 1, 0, 0, 0, 0, time.UTC)
+
+var (
+	// kanjiDatePattern 「令和元年5月1日」形式
+	kanjiDatePattern = regexp.MustCompile(`^(\p{Han}+)(元|\d+)年(\d+)月(\d+)日$`)
+	// abbreviatedDatePattern 「R1.5.1」「R01/05/01」形式
+	abbreviatedDatePattern = regexp.MustCompile(`^([A-Za-z]+)(\d+)[./-](\d+)[./-](\d+)$`)
+)
+
+type EraService struct{}
+
+func NewEraService() *EraService {
+	return &EraService{}
+}
+
+// GetEras 元号の一覧を取得
+func (s *EraService) GetEras() []domain.Era {
+	return eras
+}
+
+// ToJapaneseDate 西暦の日付を和暦に変換
+func (s *EraService) ToJapaneseDate(date time.Time) (*domain.JapaneseDate, error) {
+	date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	era, ok := eraOf(date)
+	if !ok {
+		return nil, domain.ErrInvalidInput
+	}
+
+	eraYear := date.Year() - era.StartDate.Year() + 1
+	return &domain.JapaneseDate{
+		Date:      date,
+		Era:       era.Name,
+		EraYear:   eraYear,
+		Month:     int(date.Month()),
+		Day:       date.Day(),
+		Formatted: formatEraYear(era.Name, eraYear) + strconv.Itoa(int(date.Month())) + "月" + strconv.Itoa(date.Day()) + "日",
+	}, nil
+}
+
+// FromJapaneseDate 和暦の日付を西暦に変換
+// 元号は漢字（令和）、ローマ字（Reiwa）、頭文字（R）のいずれでも指定できる
+func (s *EraService) FromJapaneseDate(eraName string, eraYear, month, day int) (*domain.JapaneseDate, error) {
+	era, ok := findEra(eraName)
+	if !ok || eraYear < 1 || month < 1 || month > 12 || day < 1 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	year := era.StartDate.Year() + eraYear - 1
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+
+	// 存在しない日付（2月30日など）や元号の期間外（平成31年5月1日など）はエラー
+	if date.Month() != time.Month(month) || date.Before(era.StartDate) ||
+		(era.EndDate != nil && date.After(*era.EndDate)) {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return s.ToJapaneseDate(date)
+}
+
+// ParseJapaneseDate 和暦の文字列（「令和元年5月1日」「R1.5.1」など）を解析
+func (s *EraService) ParseJapaneseDate(text string) (*domain.JapaneseDate, error) {
+	text = normalizeWidth(strings.TrimSpace(text))
+
+	var parts []string
+	if m := kanjiDatePattern.FindStringSubmatch(text); m != nil {
+		parts = m
+	} else if m := abbreviatedDatePattern.FindStringSubmatch(text); m != nil {
+		parts = m
+	} else {
+		return nil, domain.ErrInvalidInput
+	}
+
+	eraYear := 1
+	if parts[2] != "元" {
+		eraYear, _ = strconv.Atoi(parts[2])
+	}
+	month, _ := strconv.Atoi(parts[3])
+	day, _ := strconv.Atoi(parts[4])
+
+	return s.FromJapaneseDate(parts[1], eraYear, month, day)
+}
+
+// eraOf 指定日の元号を取得
+func eraOf(date time.Time) (domain.Era, bool) {
+	if date.Before(gregorianAdoption) {
+		return domain.Era{}, false
+	}
+	for _, era := range eras {
+		if !date.Before(era.StartDate) {
+			return era, true
+		}
+	}
+	return domain.Era{}, false
+}
+
+// findEra 元号名（漢字・ローマ字・頭文字）から元号を検索
+func findEra(name string) (domain.Era, bool) {
+	for _, era := range eras {
+		if name == era.Name || strings.EqualFold(name, era.Romaji) || strings.EqualFold(name, era.Romaji[:1]) {
+			return era, true
+		}
+	}
+	return domain.Era{}, false
+}
+
+// formatEraYear 元号と年を「令和元年」「令和7年」の形式で表記
+func formatEraYear(eraName string, eraYear int) string {
+	if eraYear == 1 {
+		return eraName + "元年"
+	}
+	return eraName + strconv.Itoa(eraYear) + "年"
+}
+
+// getEraYears 期間中の元号と年の一覧を取得（改元を含む月は複数になる）
+func getEraYears(start, end time.Time) []domain.EraYear {
+	eraYears := []domain.EraYear{}
+	for _, d := range []time.Time{start, end} {
+		era, ok := eraOf(d)
+		if !ok {
+			continue
+		}
+		if len(eraYears) > 0 && eraYears[len(eraYears)-1].Era == era.Name {
+			continue
+		}
+		year := d.Year() - era.StartDate.Year() + 1
+		eraYears = append(eraYears, domain.EraYear{Era: era.Name, Year: year, Label: formatEraYear(era.Name, year)})
+	}
+	return eraYears
+}
+
+// normalizeWidth 全角の英数字・記号を半角に変換
+func normalizeWidth(text string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '！' && r <= '～' {
+			return r - '！' + '!'
+		}
+		if r == '　' {
+			return ' '
+		}
+		return r
+	}, text)
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+func TestNewEraService(t *testing.T) {
+	service := NewEraService()
+
+	if service == nil {
+		t.Error("NewEraService should return a non-nil service")
+	}
+}
+
+func TestEraService_ToJapaneseDate(t *testing.T) {
+	service := NewEraService()
+
+	tests := []struct {
+		date      time.Time
+		era       string
+		eraYear   int
+		formatted string
+	}{
+		{time.Date(2019, 4, 30, 0, 0, 0, 0, time.UTC), "平成", 31, "平成31年4月30日"},
+		{time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC), "令和", 1, "令和元年5月1日"},
+		{time.Date(2025, 12, 3, 0, 0, 0, 0, time.UTC), "令和", 7, "令和7年12月3日"},
+		{time.Date(1989, 1, 7, 0, 0, 0, 0, time.UTC), "昭和", 64, "昭和64年1月7日"},
+		{time.Date(1989, 1, 8, 0, 0, 0, 0, time.UTC), "平成", 1, "平成元年1月8日"},
+		{time.Date(1926, 12, 25, 0, 0, 0, 0, time.UTC), "昭和", 1, "昭和元年12月25日"},
+		{time.Date(1912, 7, 30, 0, 0, 0, 0, time.UTC), "大正", 1, "大正元年7月30日"},
+		{time.Date(1873, 1, 1, 0, 0, 0, 0, time.UTC), "明治", 6, "明治6年1月1日"},
+	}
+
+	for _, test := range tests {
+		result, err := service.ToJapaneseDate(test.date)
+		if err != nil {
+			t.Errorf("ToJapaneseDate(%v) should not return error: %v", test.date, err)
+			continue
+		}
+		if result.Era != test.era || result.EraYear != test.eraYear {
+			t.Errorf("Expected %s%d, got %s%d", test.era, test.eraYear, result.Era, result.EraYear)
+		}
+		if result.Formatted != test.formatted {
+			t.Errorf("Expected '%s', got '%s'", test.formatted, result.Formatted)
+		}
+	}
+}
+
+func TestEraService_ToJapaneseDate_BeforeGregorian(t *testing.T) {
+	service := NewEraService()
+
+	_, err := service.ToJapaneseDate(time.Date(1872, 12, 31, 0, 0, 0, 0, time.UTC))
+	if err != domain.ErrInvalidInput {
+		t.Errorf("Expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestEraService_FromJapaneseDate(t *testing.T) {
+	service := NewEraService()
+
+	tests := []struct {
+		era      string
+		year     int
+		month    int
+		day      int
+		expected time.Time
+	}{
+		{"令和", 1, 5, 1, time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{"Reiwa", 7, 1, 1, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"H", 31, 4, 30, time.Date(2019, 4, 30, 0, 0, 0, 0, time.UTC)},
+		{"昭和", 64, 1, 7, time.Date(1989, 1, 7, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		result, err := service.FromJapaneseDate(test.era, test.year, test.month, test.day)
+		if err != nil {
+			t.Errorf("FromJapaneseDate(%s, %d, %d, %d) should not return error: %v", test.era, test.year, test.month, test.day, err)
+			continue
+		}
+		if !result.Date.Equal(test.expected) {
+			t.Errorf("Expected %v, got %v", test.expected, result.Date)
+		}
+	}
+}
+
+func TestEraService_FromJapaneseDate_Invalid(t *testing.T) {
+	service := NewEraService()
+
+	tests := []struct {
+		name  string
+		era   string
+		year  int
+		month int
+		day   int
+	}{
+		{"平成31年5月1日は令和", "平成", 31, 5, 1},
+		{"昭和64年1月8日は平成", "昭和", 64, 1, 8},
+		{"令和元年4月30日は平成", "令和", 1, 4, 30},
+		{"存在しない日付", "令和", 7, 2, 30},
+		{"0年", "令和", 0, 1, 1},
+		{"不明な元号", "慶応", 1, 1, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := service.FromJapaneseDate(test.era, test.year, test.month, test.day)
+			if err != domain.ErrInvalidInput {
+				t.Errorf("Expected ErrInvalidInput, got %v", err)
+			}
+		})
+	}
+}
+
+func TestEraService_ParseJapaneseDate(t *testing.T) {
+	service := NewEraService()
+
+	tests := []struct {
+		text     string
+		expected time.Time
+	}{
+		{"令和元年5月1日", time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{"令和７年１２月３日", time.Date(2025, 12, 3, 0, 0, 0, 0, time.UTC)},
+		{"平成元年1月8日", time.Date(1989, 1, 8, 0, 0, 0, 0, time.UTC)},
+		{"R1.5.1", time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{"S64/01/07", time.Date(1989, 1, 7, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		result, err := service.ParseJapaneseDate(test.text)
+		if err != nil {
+			t.Errorf("ParseJapaneseDate(%s) should not return error: %v", test.text, err)
+			continue
+		}
+		if !result.Date.Equal(test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.text, test.expected, result.Date)
+		}
+	}
+
+	if _, err := service.ParseJapaneseDate("2025-05-01"); err != domain.ErrInvalidInput {
+		t.Errorf("Expected ErrInvalidInput for non-era text, got %v", err)
+	}
+}
+
+func TestCalendarService_GetCalendar_Eras(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+
+	tests := []struct {
+		year     int
+		month    int
+		expected []string
+	}{
+		{2025, 12, []string{"令和7年"}},
+		{2019, 5, []string{"令和元年"}},
+		{2019, 4, []string{"平成31年"}},
+		{1989, 1, []string{"昭和64年", "平成元年"}},
+	}
+
+	for _, test := range tests {
+		calendar, err := service.GetCalendar(test.year, test.month, domain.CalendarOptions{})
+		if err != nil {
+			t.Fatalf("Failed to get calendar: %v", err)
+		}
+		if len(calendar.Eras) != len(test.expected) {
+			t.Errorf("%d-%02d: expected %v, got %v", test.year, test.month, test.expected, calendar.Eras)
+			continue
+		}
+		for i, label := range test.expected {
+			if calendar.Eras[i].Label != label {
+				t.Errorf("%d-%02d: expected %s, got %s", test.year, test.month, label, calendar.Eras[i].Label)
+			}
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"html"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+	"golang.org/x/text/unicode/norm"
+)
+
+// イベント検索の件数と検索語の上限
+const (
+	defaultEventSearchLimit = 20
+	maxEventSearchLimit     = 100
+	maxEventSearchRunes     = 100
+	maxEventSearchTerms     = 10
+)
+
+// 説明の抜き出しの長さ（文字数）
+const (
+	snippetLeadingRunes = 30
+	snippetRunes        = 120
+)
+
+// SearchEvents タイトル・説明にすべての検索語を含むイベントを関連度の高い順に取得
+// 検索語は全角・半角、ひらがな・カタカナ、大文字・小文字を区別しない
+func (s *EventService) SearchEvents(query string, limit int) ([]domain.EventSearchResult, error) {
+	if limit == 0 {
+		limit = defaultEventSearchLimit
+	}
+	if limit < 0 || limit > maxEventSearchLimit || utf8.RuneCountInString(query) > maxEventSearchRunes {
+		return nil, domain.ErrInvalidInput
+	}
+	terms := searchTerms(query)
+	if len(terms) == 0 || len(terms) > maxEventSearchTerms {
+		return nil, domain.ErrInvalidInput
+	}
+
+	results, err := s.repo.Search(terms, limit, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	for i := range results {
+		event := &results[i].Event
+		results[i].Highlights.Title, _ = highlightText(event.Title, terms, 0)
+		if description, ok := highlightText(event.Description, terms, snippetRunes); ok {
+			results[i].Highlights.Description = description
+		}
+		*event = localizeEvent(*event, nil)
+	}
+	return results, nil
+}
+
+// normalizeSearchText 検索用に正規化（NFKC で全角英数字・半角カナを揃え、ひらがなをカタカナ、英字を小文字にする）
+// DBの normalize_search_text 関数と同じ規則とする
+func normalizeSearchText(value string) string {
+	return foldSearchText(norm.NFKC.String(value))
+}
+
+// foldSearchText ひらがなをカタカナ、英字を小文字にする
+func foldSearchText(value string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'ぁ' && r <= 'ゖ') || r == 'ゝ' || r == 'ゞ' {
+			return r + 0x60
+		}
+		return r
+	}, strings.ToLower(value))
+}
+
+// searchTerms 検索文字列を正規化して空白で区切った検索語（重複を除く）
+func searchTerms(query string) []string {
+	terms := []string{}
+	seen := map[string]bool{}
+	for _, term := range strings.Fields(normalizeSearchText(query)) {
+		if !seen[term] {
+			seen[term] = true
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+// highlightText 検索語に一致した部分を <mark> で囲み、HTMLエスケープした文字列
+// 正規化の単位（濁点付きの半角カナなど）ごとに元の文字列と対応付ける。
+// snippet が正の場合は最初に一致した部分の前後 snippet 文字程度を抜き出す。一致しなかった場合は ok が false
+func highlightText(text string, terms []string, snippet int) (highlighted string, ok bool) {
+	// 元の文字列の各区切りと、正規化した文字列の各バイトがどの区切りから来たか
+	type segment struct {
+		text   string
+		marked bool
+	}
+	segments := []segment{}
+	normalized := strings.Builder{}
+	owners := []int{}
+
+	var it norm.Iter
+	it.InitString(norm.NFKC, text)
+	for !it.Done() {
+		start := it.Pos()
+		out := foldSearchText(string(it.Next()))
+		segments = append(segments, segment{text: text[start:it.Pos()]})
+		normalized.WriteString(out)
+		for i := 0; i < len(out); i++ {
+			owners = append(owners, len(segments)-1)
+		}
+	}
+
+	first := -1
+	value := normalized.String()
+	for _, term := range terms {
+		for offset := 0; ; {
+			i := strings.Index(value[offset:], term)
+			if i < 0 {
+				break
+			}
+			i += offset
+			for j := owners[i]; j <= owners[i+len(term)-1]; j++ {
+				segments[j].marked = true
+			}
+			if first < 0 || owners[i] < first {
+				first = owners[i]
+			}
+			offset = i + len(term)
+		}
+	}
+	if first < 0 {
+		return html.EscapeString(text), false
+	}
+
+	from, to := 0, len(segments)
+	if snippet > 0 && len(segments) > snippet {
+		if from = first - snippetLeadingRunes; from < 0 {
+			from = 0
+		}
+		if to = from + snippet; to > len(segments) {
+			to, from = len(segments), len(segments)-snippet
+		}
+	}
+
+	var b strings.Builder
+	if from > 0 {
+		b.WriteString("…")
+	}
+	for i := from; i < to; i++ {
+		if segments[i].marked && (i == from || !segments[i-1].marked) {
+			b.WriteString("<mark>")
+		}
+		b.WriteString(html.EscapeString(segments[i].text))
+		if segments[i].marked && (i == to-1 || !segments[i+1].marked) {
+			b.WriteString("</mark>")
+		}
+	}
+	if to < len(segments) {
+		b.WriteString("…")
+	}
+	return b.String(), true
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+func TestNormalizeSearchText(t *testing.T) {
+	tests := map[string]string{
+		"ＡＢＣ１２３":   "abc123",
+		"ﾃｲﾚｲｶｲｷﾞ": "テイレイカイギ",
+		"ていれいかいぎ":  "テイレイカイギ",
+		"Go言語勉強会":  "go言語勉強会",
+		"いすゞ":      "イスヾ",
+		"　全角　空白　":  " 全角 空白 ",
+	}
+	for value, expected := range tests {
+		if got := normalizeSearchText(value); got != expected {
+			t.Errorf("%q: Expected %q, got %q", value, expected, got)
+		}
+	}
+}
+
+func TestSearchTerms(t *testing.T) {
+	got := searchTerms("　ていれい　ﾃｲﾚｲ  ＭＴＧ ")
+	if !equalStrings(got, []string{"テイレイ", "mtg"}) {
+		t.Errorf("Unexpected terms: %v", got)
+	}
+}
+
+func TestHighlightText(t *testing.T) {
+	tests := []struct {
+		text     string
+		terms    []string
+		expected string
+		ok       bool
+	}{
+		{"定例会議", []string{"定例"}, "<mark>定例</mark>会議", true},
+		// 半角カナの濁点は正規化した文字と合わせて囲む
+		{"ﾃｲﾚｲｶｲｷﾞ", []string{"カイギ"}, "ﾃｲﾚｲ<mark>ｶｲｷﾞ</mark>", true},
+		{"ていれい会議とテイレイ", []string{"テイレイ"}, "<mark>ていれい</mark>会議と<mark>テイレイ</mark>", true},
+		{"Go <b>勉強会</b>", []string{"go", "勉強"}, "<mark>Go</mark> &lt;b&gt;<mark>勉強</mark>会&lt;/b&gt;", true},
+		{"ＡＢＣ研修", []string{"abc"}, "<mark>ＡＢＣ</mark>研修", true},
+		{"営業会議", []string{"定例"}, "営業会議", false},
+	}
+	for _, test := range tests {
+		got, ok := highlightText(test.text, test.terms, 0)
+		if got != test.expected || ok != test.ok {
+			t.Errorf("%q: Expected %q (%v), got %q (%v)", test.text, test.expected, test.ok, got, ok)
+		}
+	}
+}
+
+func TestHighlightText_Snippet(t *testing.T) {
+	text := strings.Repeat("あ", 100) + "議題" + strings.Repeat("い", 100)
+	got, ok := highlightText(text, []string{"議題"}, snippetRunes)
+	if !ok {
+		t.Fatal("Expected a match")
+	}
+	expected := "…" + strings.Repeat("あ", snippetLeadingRunes) + "<mark>議題</mark>" + strings.Repeat("い", snippetRunes-snippetLeadingRunes-2) + "…"
+	if got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+
+	// 末尾付近で一致した場合も snippetRunes 文字を抜き出す
+	got, _ = highlightText(strings.Repeat("あ", 200)+"議題", []string{"議題"}, snippetRunes)
+	if expected := "…" + strings.Repeat("あ", snippetRunes-2) + "<mark>議題</mark>"; got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
+
+func TestEventService_SearchEvents(t *testing.T) {
+	var gotTerms []string
+	var gotLimit int
+	repo := &MockEventRepository{
+		SearchFunc: func(terms []string, limit int, now time.Time) ([]domain.EventSearchResult, error) {
+			gotTerms, gotLimit = terms, limit
+			return []domain.EventSearchResult{
+				{
+					Event: domain.Event{
+						ID:          1,
+						Title:       "ﾃｲﾚｲ会議",
+						Description: "来週の定例の議題",
+						StartDate:   time.Date(2025, 1, 6, 1, 0, 0, 0, time.UTC),
+						EndDate:     time.Date(2025, 1, 6, 2, 0, 0, 0, time.UTC),
+						TimeZone:    "Asia/Tokyo",
+					},
+					Score: 1.5,
+				},
+				{
+					Event: domain.Event{ID: 2, Title: "ていれい", Description: "会議室A", TimeZone: "Asia/Tokyo"},
+					Score: 1.2,
+				},
+			}, nil
+		},
+	}
+	service := NewEventService(repo, nil)
+
+	results, err := service.SearchEvents("テイレイ　会議", 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !equalStrings(gotTerms, []string{"テイレイ", "会議"}) || gotLimit != defaultEventSearchLimit {
+		t.Errorf("Unexpected terms and limit: %v, %d", gotTerms, gotLimit)
+	}
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 results, got %d", len(results))
+	}
+	if results[0].Highlights.Title != "<mark>ﾃｲﾚｲ会議</mark>" || results[0].Highlights.Description != "" {
+		t.Errorf("Unexpected highlights: %+v", results[0].Highlights)
+	}
+	if results[1].Highlights.Title != "<mark>ていれい</mark>" || results[1].Highlights.Description != "<mark>会議</mark>室A" {
+		t.Errorf("Unexpected highlights: %+v", results[1].Highlights)
+	}
+	if results[0].Event.StartDate.Location().String() != "Asia/Tokyo" {
+		t.Errorf("Expected the event in its own time zone, got %v", results[0].Event.StartDate.Location())
+	}
+}
+
+func TestEventService_SearchEvents_Invalid(t *testing.T) {
+	service := NewEventService(&MockEventRepository{}, nil)
+
+	tests := []struct {
+		query string
+		limit int
+	}{
+		{"", 0},
+		{"　 ", 0},
+		{"会議", -1},
+		{"会議", maxEventSearchLimit + 1},
+		{strings.Repeat("会", maxEventSearchRunes+1), 0},
+		{"a b c d e f g h i j k", 0},
+	}
+	for _, test := range tests {
+		if _, err := service.SearchEvents(test.query, test.limit); err != domain.ErrInvalidInput {
+			t.Errorf("%q (%d): Expected ErrInvalidInput, got %v", test.query, test.limit, err)
+		}
+	}
+}
@@ -1,38 +1,160 @@
 package service
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
 )
 
+// DefaultEventTimeZone イベントのタイムゾーンが指定されなかった場合に使用するタイムゾーン
+const DefaultEventTimeZone = "Asia/Tokyo"
+
+// maxEventDateRangeYears 期間を指定してイベントを取得する場合の期間の上限（年）
+const maxEventDateRangeYears = 1
+
+// イベント一覧の1ページの件数
+const (
+	defaultEventPageSize = 100
+	maxEventPageSize     = 500
+)
+
+// locationCache 読み込んだタイムゾーンのキャッシュ
+var locationCache sync.Map
+
 type EventService struct {
-	repo EventRepositoryInterface
+	repo     EventRepositoryInterface
+	calendar HolidayCalendarInterface
 }
 
 type EventRepositoryInterface interface {
-	GetAll() ([]domain.Event, error)
+	List(opts domain.EventListOptions, after *domain.EventCursor) ([]domain.Event, error)
+	Search(terms []string, limit int, now time.Time) ([]domain.EventSearchResult, error)
 	GetByID(id int) (*domain.Event, error)
 	GetByDateRange(start, end time.Time) ([]domain.Event, error)
 	Create(event *domain.Event) error
 	Update(event *domain.Event) error
+	UpdateSeries(series, created *domain.Event) error
 	Delete(id int) error
 }
 
-func NewEventService(repo EventRepositoryInterface) *EventService {
-	return &EventService{repo: repo}
+// NewEventService calendar は営業日に基づく繰り返しの休日の判定に使う（nil の場合は営業日に基づく繰り返しを扱わない）
+func NewEventService(repo EventRepositoryInterface, calendar HolidayCalendarInterface) *EventService {
+	return &EventService{repo: repo, calendar: calendar}
 }
 
-func (s *EventService) GetAllEvents() ([]domain.Event, error) {
-	return s.repo.GetAll()
+// ListEvents 絞り込んだイベントを並び替えて1ページ分取得
+// 次のページがある場合は、ページの最後のイベントを表すカーソルを NextCursor に設定する
+func (s *EventService) ListEvents(opts domain.EventListOptions) (*domain.EventPage, error) {
+	if opts.Sort == "" {
+		opts.Sort = domain.EventSortStartDate
+	}
+	if opts.Sort != domain.EventSortStartDate && opts.Sort != domain.EventSortUpdatedAt && opts.Sort != domain.EventSortTitle {
+		return nil, domain.ErrInvalidInput
+	}
+	if opts.Limit == 0 {
+		opts.Limit = defaultEventPageSize
+	}
+	if opts.Limit < 0 || opts.Limit > maxEventPageSize {
+		return nil, domain.ErrInvalidInput
+	}
+
+	var after *domain.EventCursor
+	if opts.Cursor != "" {
+		cursor, err := decodeEventCursor(opts.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		// カーソルは同じ並び順の一覧でのみ使える
+		if cursor.Sort != opts.Sort || cursor.Desc != opts.Desc {
+			return nil, domain.ErrInvalidInput
+		}
+		after = cursor
+	}
+
+	// 次のページの有無を知るため1件多く取得する
+	limit := opts.Limit
+	opts.Limit++
+	events, err := s.repo.List(opts, after)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.EventPage{Events: events}
+	if len(events) > limit {
+		page.Events = events[:limit]
+		page.NextCursor = encodeEventCursor(eventCursorOf(page.Events[limit-1], opts))
+	}
+	page.Events = localizeEvents(page.Events)
+	return page, nil
+}
+
+// eventCursorOf イベントの並び替えの値とIDのカーソル
+func eventCursorOf(event domain.Event, opts domain.EventListOptions) domain.EventCursor {
+	cursor := domain.EventCursor{Sort: opts.Sort, Desc: opts.Desc, ID: event.ID}
+	switch opts.Sort {
+	case domain.EventSortUpdatedAt:
+		cursor.Value = event.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	case domain.EventSortTitle:
+		cursor.Value = event.Title
+	default:
+		cursor.Value = event.StartDate.UTC().Format(time.RFC3339Nano)
+	}
+	return cursor
+}
+
+// encodeEventCursor カーソルをURLで使える不透明な文字列にする
+func encodeEventCursor(cursor domain.EventCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeEventCursor encodeEventCursor で作った文字列をカーソルに戻す
+func decodeEventCursor(value string) (*domain.EventCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, domain.ErrInvalidInput
+	}
+	var cursor domain.EventCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID <= 0 {
+		return nil, domain.ErrInvalidInput
+	}
+	if cursor.Sort != domain.EventSortTitle {
+		if _, err := time.Parse(time.RFC3339Nano, cursor.Value); err != nil {
+			return nil, domain.ErrInvalidInput
+		}
+	}
+	return &cursor, nil
 }
 
 func (s *EventService) GetEventByID(id int) (*domain.Event, error) {
-	return s.repo.GetByID(id)
+	event, err := s.repo.GetByID(id)
+	if err != nil || event == nil {
+		return event, err
+	}
+	localized := localizeEvent(*event, nil)
+	return &localized, nil
 }
 
+// GetEventsByDateRange 期間内のイベントを取得（繰り返しイベントは期間内の各回に展開する）
+// 期間は1年以内とする
 func (s *EventService) GetEventsByDateRange(start, end time.Time) ([]domain.Event, error) {
-	return s.repo.GetByDateRange(start, end)
+	if end.Before(start) || end.After(start.AddDate(maxEventDateRangeYears, 0, 0)) {
+		return nil, domain.ErrInvalidInput
+	}
+
+	events, err := s.repo.GetByDateRange(start, end)
+	if err != nil {
+		return nil, err
+	}
+	expanded, err := expandEvents(events, start, end, s.calendar)
+	if err != nil {
+		return nil, err
+	}
+	return localizeEvents(expanded), nil
 }
 
 func (s *EventService) CreateEvent(event *domain.Event) error {
@@ -43,6 +165,16 @@ func (s *EventService) CreateEvent(event *domain.Event) error {
 	if event.EndDate.Before(event.StartDate) {
 		return domain.ErrInvalidInput
 	}
+	if err := normalizeEventTimeZone(event); err != nil {
+		return err
+	}
+	if err := validateRecurrence(event, s.calendar); err != nil {
+		return err
+	}
+
+	// シリーズとの関連は「この回のみ」の変更で切り離すときにだけ設定する
+	event.RecurringEventID = nil
+	event.RecurrenceID = nil
 
 	return s.repo.Create(event)
 }
@@ -55,6 +187,12 @@ func (s *EventService) UpdateEvent(event *domain.Event) error {
 	if event.EndDate.Before(event.StartDate) {
 		return domain.ErrInvalidInput
 	}
+	if err := normalizeEventTimeZone(event); err != nil {
+		return err
+	}
+	if err := validateRecurrence(event, s.calendar); err != nil {
+		return err
+	}
 
 	existing, err := s.repo.GetByID(event.ID)
 	if err != nil {
@@ -64,9 +202,158 @@ func (s *EventService) UpdateEvent(event *domain.Event) error {
 		return domain.ErrNotFound
 	}
 
+	// シリーズとの関連はリクエストの内容によらず保存済みのものを引き継ぐ
+	event.RecurringEventID = existing.RecurringEventID
+	event.RecurrenceID = existing.RecurrenceID
+
 	return s.repo.Update(event)
 }
 
+// UpdateEventOccurrences 繰り返しイベントの recurrenceID の回を指定した範囲で変更
+// this はその回をシリーズから除いて独立したイベントとし、following はシリーズをその回の前で終わらせて
+// 以降の回を新しいシリーズとする。変更後のイベント（新しく作ったイベントまたはシリーズ）を event に設定する
+func (s *EventService) UpdateEventOccurrences(event *domain.Event, scope string, recurrenceID time.Time) error {
+	if scope == "" || scope == domain.EventEditScopeAll {
+		return s.UpdateEvent(event)
+	}
+	if scope != domain.EventEditScopeThis && scope != domain.EventEditScopeFollowing {
+		return domain.ErrInvalidInput
+	}
+
+	// バリデーション
+	if event.Title == "" {
+		return domain.ErrInvalidInput
+	}
+	if event.EndDate.Before(event.StartDate) {
+		return domain.ErrInvalidInput
+	}
+	if err := normalizeEventTimeZone(event); err != nil {
+		return err
+	}
+
+	series, err := s.repo.GetByID(event.ID)
+	if err != nil {
+		return err
+	}
+	if series == nil {
+		return domain.ErrNotFound
+	}
+	if !isRecurringEvent(*series) {
+		return domain.ErrInvalidInput
+	}
+	occurrence, ok, err := findOccurrence(*series, recurrenceID, s.calendar)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return domain.ErrNotFound
+	}
+	recurrenceID = *occurrence.RecurrenceID
+
+	if scope == domain.EventEditScopeThis {
+		return s.detachOccurrence(series, event, recurrenceID)
+	}
+
+	// 休業日に当たり移動した回から分ける場合は、新しいシリーズを移動前の本来の日付から始める
+	loc := eventLocation(*series)
+	if days := int(truncateToDate(occurrence.StartDate.In(loc)).Sub(truncateToDate(recurrenceID.In(loc))).Hours() / 24); days != 0 {
+		event.StartDate = event.StartDate.In(loc).AddDate(0, 0, -days)
+		event.EndDate = event.EndDate.In(loc).AddDate(0, 0, -days)
+	}
+	return s.splitSeries(series, event, recurrenceID)
+}
+
+// detachOccurrence 繰り返しの1回をシリーズから除き、独立したイベントとして作成
+func (s *EventService) detachOccurrence(series, event *domain.Event, recurrenceID time.Time) error {
+	seriesID := series.ID
+	detached := *event
+	detached.ID = 0
+	detached.RRule = ""
+	detached.ExDates = nil
+	detached.RDates = nil
+	detached.RecurringEventID = &seriesID
+	detached.RecurrenceID = &recurrenceID
+
+	series.ExDates = append(series.ExDates, recurrenceID)
+	if err := s.repo.UpdateSeries(series, &detached); err != nil {
+		return err
+	}
+
+	*event = detached
+	return nil
+}
+
+// splitSeries シリーズを recurrenceID の回の前で終わらせ、以降の回を event の内容の新しいシリーズとする
+// event の RRule が元のシリーズと同じで COUNT を持つ場合は、新しいシリーズの COUNT を残りの回数にする
+func (s *EventService) splitSeries(series, event *domain.Event, recurrenceID time.Time) error {
+	if !recurrenceID.After(series.StartDate) {
+		// 最初の回以降の変更はシリーズ全体の変更と同じ
+		return s.UpdateEvent(event)
+	}
+
+	following := *event
+	following.ID = 0
+	following.RecurringEventID = nil
+	following.RecurrenceID = nil
+	following.ExDates = datesFrom(event.ExDates, recurrenceID)
+	following.RDates = datesFrom(event.RDates, recurrenceID)
+
+	previousRule := series.RRule
+	if series.RRule != "" {
+		rule, err := newEventRule(*series, s.calendar)
+		if err != nil {
+			return err
+		}
+		before, err := countOccurrencesBefore(*series, rule, recurrenceID)
+		if err != nil {
+			return err
+		}
+		if rule.Count > 0 {
+			previousRule = replaceRRuleParts(series.RRule, map[string]string{"COUNT": strconv.Itoa(before)})
+			if following.RRule == series.RRule {
+				following.RRule = replaceRRuleParts(series.RRule, map[string]string{"COUNT": strconv.Itoa(rule.Count - before)})
+			}
+		} else {
+			previousRule = replaceRRuleParts(series.RRule, map[string]string{"UNTIL": formatRRuleUntil(recurrenceID.Add(-time.Second))})
+		}
+	}
+	if err := validateRecurrence(&following, s.calendar); err != nil {
+		return err
+	}
+
+	series.RRule = previousRule
+	series.ExDates = datesBefore(series.ExDates, recurrenceID)
+	series.RDates = datesBefore(series.RDates, recurrenceID)
+	if err := s.repo.UpdateSeries(series, &following); err != nil {
+		return err
+	}
+
+	*event = following
+	return nil
+}
+
+// datesBefore at より前の日時
+func datesBefore(dates []time.Time, at time.Time) []time.Time {
+	result := []time.Time{}
+	for _, date := range dates {
+		if date.Before(at) {
+			result = append(result, date)
+		}
+	}
+	return result
+}
+
+// datesFrom at 以降の日時
+func datesFrom(dates []time.Time, at time.Time) []time.Time {
+	result := []time.Time{}
+	for _, date := range dates {
+		if !date.Before(at) {
+			result = append(result, date)
+		}
+	}
+	return result
+}
+
 func (s *EventService) DeleteEvent(id int) error {
 	existing, err := s.repo.GetByID(id)
 	if err != nil {
@@ -78,3 +365,58 @@ func (s *EventService) DeleteEvent(id int) error {
 
 	return s.repo.Delete(id)
 }
+
+// normalizeEventTimeZone イベントのタイムゾーンを検証し、未指定の場合は既定のタイムゾーンを設定
+func normalizeEventTimeZone(event *domain.Event) error {
+	if event.TimeZone == "" {
+		event.TimeZone = DefaultEventTimeZone
+	}
+	if _, err := loadLocation(event.TimeZone); err != nil {
+		return domain.ErrInvalidInput
+	}
+	return nil
+}
+
+// loadLocation IANAタイムゾーン名からタイムゾーンを読み込む
+func loadLocation(name string) (*time.Location, error) {
+	if loc, ok := locationCache.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	if name == "" || name == "Local" {
+		return nil, domain.ErrInvalidInput
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	locationCache.Store(name, loc)
+	return loc, nil
+}
+
+// eventLocation イベントのタイムゾーン（読み込めない場合はUTC）
+func eventLocation(event domain.Event) *time.Location {
+	loc, err := loadLocation(event.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// localizeEvent イベントの日時を指定したタイムゾーンで表す（nil の場合はイベントのタイムゾーン）
+func localizeEvent(event domain.Event, loc *time.Location) domain.Event {
+	if loc == nil {
+		loc = eventLocation(event)
+	}
+	event.StartDate = event.StartDate.In(loc)
+	event.EndDate = event.EndDate.In(loc)
+	return event
+}
+
+// localizeEvents 各イベントの日時をイベントのタイムゾーンで表す
+func localizeEvents(events []domain.Event) []domain.Event {
+	localized := make([]domain.Event, 0, len(events))
+	for _, event := range events {
+		localized = append(localized, localizeEvent(event, nil))
+	}
+	return localized
+}
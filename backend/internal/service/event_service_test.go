@@ -1,6 +1,7 @@
 package service
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -9,21 +10,16 @@ import (
 
 // MockEventRepository はテスト用のモックリポジトリ
 type MockEventRepository struct {
-	GetAllFunc         func() ([]domain.Event, error)
 	GetByIDFunc        func(id int) (*domain.Event, error)
 	GetByDateRangeFunc func(start, end time.Time) ([]domain.Event, error)
+	ListFunc           func(opts domain.EventListOptions, after *domain.EventCursor) ([]domain.Event, error)
+	SearchFunc         func(terms []string, limit int, now time.Time) ([]domain.EventSearchResult, error)
 	CreateFunc         func(event *domain.Event) error
 	UpdateFunc         func(event *domain.Event) error
+	UpdateSeriesFunc   func(series, created *domain.Event) error
 	DeleteFunc         func(id int) error
 }
 
-func (m *MockEventRepository) GetAll() ([]domain.Event, error) {
-	if m.GetAllFunc != nil {
-		return m.GetAllFunc()
-	}
-	return []domain.Event{}, nil
-}
-
 func (m *MockEventRepository) GetByID(id int) (*domain.Event, error) {
 	if m.GetByIDFunc != nil {
 		return m.GetByIDFunc(id)
@@ -38,6 +34,20 @@ func (m *MockEventRepository) GetByDateRange(start, end time.Time) ([]domain.Eve
 	return []domain.Event{}, nil
 }
 
+func (m *MockEventRepository) List(opts domain.EventListOptions, after *domain.EventCursor) ([]domain.Event, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(opts, after)
+	}
+	return []domain.Event{}, nil
+}
+
+func (m *MockEventRepository) Search(terms []string, limit int, now time.Time) ([]domain.EventSearchResult, error) {
+	if m.SearchFunc != nil {
+		return m.SearchFunc(terms, limit, now)
+	}
+	return []domain.EventSearchResult{}, nil
+}
+
 func (m *MockEventRepository) Create(event *domain.Event) error {
 	if m.CreateFunc != nil {
 		return m.CreateFunc(event)
@@ -52,6 +62,13 @@ func (m *MockEventRepository) Update(event *domain.Event) error {
 	return nil
 }
 
+func (m *MockEventRepository) UpdateSeries(series, created *domain.Event) error {
+	if m.UpdateSeriesFunc != nil {
+		return m.UpdateSeriesFunc(series, created)
+	}
+	return nil
+}
+
 func (m *MockEventRepository) Delete(id int) error {
 	if m.DeleteFunc != nil {
 		return m.DeleteFunc(id)
@@ -61,55 +78,13 @@ func (m *MockEventRepository) Delete(id int) error {
 
 func TestNewEventService(t *testing.T) {
 	repo := &MockEventRepository{}
-	service := NewEventService(repo)
+	service := NewEventService(repo, nil)
 
 	if service == nil {
 		t.Error("NewEventService should return a non-nil service")
 	}
 }
 
-func TestEventService_GetAllEvents(t *testing.T) {
-	mockEvents := []domain.Event{
-		{
-			ID:          1,
-			Title:       "テストイベント1",
-			Description: "説明1",
-			StartDate:   time.Now(),
-			EndDate:     time.Now().Add(time.Hour),
-			AllDay:      false,
-		},
-		{
-			ID:          2,
-			Title:       "テストイベント2",
-			Description: "説明2",
-			StartDate:   time.Now(),
-			EndDate:     time.Now().Add(2 * time.Hour),
-			AllDay:      true,
-		},
-	}
-
-	repo := &MockEventRepository{
-		GetAllFunc: func() ([]domain.Event, error) {
-			return mockEvents, nil
-		},
-	}
-
-	service := NewEventService(repo)
-	events, err := service.GetAllEvents()
-
-	if err != nil {
-		t.Errorf("GetAllEvents should not return error: %v", err)
-	}
-
-	if len(events) != 2 {
-		t.Errorf("Expected 2 events, got %d", len(events))
-	}
-
-	if events[0].Title != "テストイベント1" {
-		t.Errorf("Expected first event title 'テストイベント1', got '%s'", events[0].Title)
-	}
-}
-
 func TestEventService_CreateEvent_Success(t *testing.T) {
 	startDate := time.Now()
 	endDate := startDate.Add(time.Hour)
@@ -131,7 +106,7 @@ func TestEventService_CreateEvent_Success(t *testing.T) {
 		},
 	}
 
-	service := NewEventService(repo)
+	service := NewEventService(repo, nil)
 	err := service.CreateEvent(event)
 
 	if err != nil {
@@ -153,7 +128,7 @@ func TestEventService_CreateEvent_EmptyTitle(t *testing.T) {
 	}
 
 	repo := &MockEventRepository{}
-	service := NewEventService(repo)
+	service := NewEventService(repo, nil)
 	err := service.CreateEvent(event)
 
 	if err != domain.ErrInvalidInput {
@@ -174,7 +149,7 @@ func TestEventService_CreateEvent_InvalidDateRange(t *testing.T) {
 	}
 
 	repo := &MockEventRepository{}
-	service := NewEventService(repo)
+	service := NewEventService(repo, nil)
 	err := service.CreateEvent(event)
 
 	if err != domain.ErrInvalidInput {
@@ -214,7 +189,7 @@ func TestEventService_UpdateEvent_Success(t *testing.T) {
 		},
 	}
 
-	service := NewEventService(repo)
+	service := NewEventService(repo, nil)
 	err := service.UpdateEvent(updatedEvent)
 
 	if err != nil {
@@ -238,7 +213,7 @@ func TestEventService_UpdateEvent_NotFound(t *testing.T) {
 		},
 	}
 
-	service := NewEventService(repo)
+	service := NewEventService(repo, nil)
 	err := service.UpdateEvent(event)
 
 	if err != domain.ErrNotFound {
@@ -246,6 +221,66 @@ func TestEventService_UpdateEvent_NotFound(t *testing.T) {
 	}
 }
 
+func TestEventService_SeriesLinkIgnoresRequest(t *testing.T) {
+	seriesID := 1
+	recurrenceID := time.Date(2025, 1, 8, 1, 0, 0, 0, time.UTC)
+	detached := &domain.Event{
+		ID:               2,
+		Title:            "切り離した回",
+		StartDate:        recurrenceID,
+		EndDate:          recurrenceID.Add(time.Hour),
+		RecurringEventID: &seriesID,
+		RecurrenceID:     &recurrenceID,
+	}
+
+	var saved *domain.Event
+	repo := &MockEventRepository{
+		GetByIDFunc: func(id int) (*domain.Event, error) {
+			if id == detached.ID {
+				return detached, nil
+			}
+			return nil, nil
+		},
+		CreateFunc: func(e *domain.Event) error {
+			saved = e
+			return nil
+		},
+		UpdateFunc: func(e *domain.Event) error {
+			saved = e
+			return nil
+		},
+	}
+	service := NewEventService(repo, nil)
+
+	// シリーズとの関連を送らない更新でも切り離した回の関連を保つ
+	update := &domain.Event{ID: 2, Title: "変更した回", StartDate: recurrenceID, EndDate: recurrenceID.Add(2 * time.Hour)}
+	if err := service.UpdateEvent(update); err != nil {
+		t.Fatalf("UpdateEvent should not return error: %v", err)
+	}
+	if saved.RecurringEventID == nil || *saved.RecurringEventID != seriesID || saved.RecurrenceID == nil || !saved.RecurrenceID.Equal(recurrenceID) {
+		t.Errorf("Expected series link to be kept, got %v %v", saved.RecurringEventID, saved.RecurrenceID)
+	}
+
+	// 別のシリーズへの関連は書き換えられない
+	otherID := 99
+	update = &domain.Event{ID: 2, Title: "変更した回", StartDate: recurrenceID, EndDate: recurrenceID.Add(time.Hour), RecurringEventID: &otherID}
+	if err := service.UpdateEvent(update); err != nil {
+		t.Fatalf("UpdateEvent should not return error: %v", err)
+	}
+	if saved.RecurringEventID == nil || *saved.RecurringEventID != seriesID {
+		t.Errorf("Expected series link %d, got %v", seriesID, saved.RecurringEventID)
+	}
+
+	// 作成時はシリーズとの関連を受け付けない
+	create := &domain.Event{Title: "新しいイベント", StartDate: recurrenceID, EndDate: recurrenceID.Add(time.Hour), RecurringEventID: &otherID, RecurrenceID: &recurrenceID}
+	if err := service.CreateEvent(create); err != nil {
+		t.Fatalf("CreateEvent should not return error: %v", err)
+	}
+	if saved.RecurringEventID != nil || saved.RecurrenceID != nil {
+		t.Errorf("Expected no series link on create, got %v %v", saved.RecurringEventID, saved.RecurrenceID)
+	}
+}
+
 func TestEventService_DeleteEvent_Success(t *testing.T) {
 	repo := &MockEventRepository{
 		GetByIDFunc: func(id int) (*domain.Event, error) {
@@ -259,7 +294,7 @@ func TestEventService_DeleteEvent_Success(t *testing.T) {
 		},
 	}
 
-	service := NewEventService(repo)
+	service := NewEventService(repo, nil)
 	err := service.DeleteEvent(1)
 
 	if err != nil {
@@ -274,10 +309,392 @@ func TestEventService_DeleteEvent_NotFound(t *testing.T) {
 		},
 	}
 
-	service := NewEventService(repo)
+	service := NewEventService(repo, nil)
 	err := service.DeleteEvent(999)
 
 	if err != domain.ErrNotFound {
 		t.Errorf("Expected ErrNotFound, got %v", err)
 	}
 }
+
+func TestEventService_CreateEvent_TimeZone(t *testing.T) {
+	var created domain.Event
+	repo := &MockEventRepository{
+		CreateFunc: func(e *domain.Event) error {
+			created = *e
+			return nil
+		},
+	}
+	service := NewEventService(repo, nil)
+
+	event := &domain.Event{
+		Title:     "タイムゾーン未指定",
+		StartDate: time.Date(2025, 12, 10, 14, 30, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, 12, 10, 15, 30, 0, 0, time.UTC),
+	}
+	if err := service.CreateEvent(event); err != nil {
+		t.Fatalf("CreateEvent should not return error: %v", err)
+	}
+	if created.TimeZone != DefaultEventTimeZone {
+		t.Errorf("Expected default time zone %s, got %q", DefaultEventTimeZone, created.TimeZone)
+	}
+
+	for _, tz := range []string{"Mars/Olympus_Mons", "Local"} {
+		event := &domain.Event{
+			Title:     "不正なタイムゾーン",
+			StartDate: time.Date(2025, 12, 10, 14, 30, 0, 0, time.UTC),
+			EndDate:   time.Date(2025, 12, 10, 15, 30, 0, 0, time.UTC),
+			TimeZone:  tz,
+		}
+		if err := service.CreateEvent(event); err != domain.ErrInvalidInput {
+			t.Errorf("%s: Expected ErrInvalidInput, got %v", tz, err)
+		}
+	}
+}
+
+func TestEventService_GetEventByID_TimeZone(t *testing.T) {
+	repo := &MockEventRepository{
+		GetByIDFunc: func(id int) (*domain.Event, error) {
+			return &domain.Event{
+				ID:        id,
+				Title:     "ニューヨーク会議",
+				StartDate: time.Date(2025, 12, 10, 14, 30, 0, 0, time.UTC),
+				EndDate:   time.Date(2025, 12, 10, 15, 30, 0, 0, time.UTC),
+				TimeZone:  "America/New_York",
+			}, nil
+		},
+	}
+	service := NewEventService(repo, nil)
+
+	event, err := service.GetEventByID(1)
+	if err != nil {
+		t.Fatalf("GetEventByID should not return error: %v", err)
+	}
+
+	// イベントのタイムゾーンの日時で返す
+	if event.StartDate.Location().String() != "America/New_York" || event.StartDate.Hour() != 9 {
+		t.Errorf("Expected 09:30 in America/New_York, got %s", event.StartDate)
+	}
+	if !event.StartDate.Equal(time.Date(2025, 12, 10, 14, 30, 0, 0, time.UTC)) {
+		t.Error("Localized time should represent the same instant")
+	}
+}
+
+// newRecurringEventTestRepository 毎週月曜日10時（日本時間）の定例会を持つリポジトリ
+// 作成・更新したイベントを created・updated に記録する
+func newRecurringEventTestRepository(rrule string, created, updated *[]domain.Event) *MockEventRepository {
+	jst, _ := time.LoadLocation("Asia/Tokyo")
+	return &MockEventRepository{
+		GetByIDFunc: func(id int) (*domain.Event, error) {
+			if id != 1 {
+				return nil, nil
+			}
+			return &domain.Event{
+				ID:        1,
+				Title:     "定例会",
+				StartDate: time.Date(2025, 1, 6, 10, 0, 0, 0, jst),
+				EndDate:   time.Date(2025, 1, 6, 11, 0, 0, 0, jst),
+				TimeZone:  "Asia/Tokyo",
+				RRule:     rrule,
+				ExDates:   []time.Time{time.Date(2025, 1, 27, 10, 0, 0, 0, jst)},
+			}, nil
+		},
+		CreateFunc: func(event *domain.Event) error {
+			event.ID = 2
+			*created = append(*created, *event)
+			return nil
+		},
+		UpdateFunc: func(event *domain.Event) error {
+			*updated = append(*updated, *event)
+			return nil
+		},
+		UpdateSeriesFunc: func(series, event *domain.Event) error {
+			event.ID = 2
+			*created = append(*created, *event)
+			*updated = append(*updated, *series)
+			return nil
+		},
+	}
+}
+
+func TestEventService_UpdateEventOccurrences_This(t *testing.T) {
+	jst, _ := time.LoadLocation("Asia/Tokyo")
+	var created, updated []domain.Event
+	service := NewEventService(newRecurringEventTestRepository("FREQ=WEEKLY;COUNT=10", &created, &updated), nil)
+
+	event := &domain.Event{
+		ID:        1,
+		Title:     "定例会（会議室変更）",
+		StartDate: time.Date(2025, 1, 13, 14, 0, 0, 0, jst),
+		EndDate:   time.Date(2025, 1, 13, 15, 0, 0, 0, jst),
+		RRule:     "FREQ=WEEKLY;COUNT=10",
+	}
+	recurrenceID := time.Date(2025, 1, 13, 1, 0, 0, 0, time.UTC)
+	if err := service.UpdateEventOccurrences(event, domain.EventEditScopeThis, recurrenceID); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(created) != 1 || len(updated) != 1 {
+		t.Fatalf("Expected 1 create and 1 update, got %d and %d", len(created), len(updated))
+	}
+	detached := created[0]
+	if detached.RRule != "" || detached.RecurringEventID == nil || *detached.RecurringEventID != 1 || !detached.RecurrenceID.Equal(recurrenceID) {
+		t.Errorf("Unexpected detached occurrence: %+v", detached)
+	}
+	if len(updated[0].ExDates) != 2 || !updated[0].ExDates[1].Equal(recurrenceID) {
+		t.Errorf("Expected the occurrence to be excluded from the series, got %v", updated[0].ExDates)
+	}
+	if event.ID != 2 {
+		t.Errorf("Expected the detached event to be returned, got ID %d", event.ID)
+	}
+}
+
+func TestEventService_UpdateEventOccurrences_Following(t *testing.T) {
+	jst, _ := time.LoadLocation("Asia/Tokyo")
+	recurrenceID := time.Date(2025, 1, 20, 10, 0, 0, 0, jst)
+
+	tests := []struct {
+		rrule         string
+		previousRule  string
+		followingRule string
+	}{
+		// 1月20日は3回目（1月6日、13日の後）
+		{"FREQ=WEEKLY;COUNT=10", "FREQ=WEEKLY;COUNT=2", "FREQ=WEEKLY;COUNT=8"},
+		{"FREQ=WEEKLY;BYDAY=MO", "FREQ=WEEKLY;BYDAY=MO;UNTIL=20250120T005959Z", "FREQ=WEEKLY;BYDAY=MO"},
+	}
+	for _, test := range tests {
+		var created, updated []domain.Event
+		service := NewEventService(newRecurringEventTestRepository(test.rrule, &created, &updated), nil)
+
+		event := &domain.Event{
+			ID:        1,
+			Title:     "定例会（30分に短縮）",
+			StartDate: recurrenceID,
+			EndDate:   recurrenceID.Add(30 * time.Minute),
+			RRule:     test.rrule,
+			ExDates:   []time.Time{time.Date(2025, 1, 27, 10, 0, 0, 0, jst)},
+		}
+		if err := service.UpdateEventOccurrences(event, domain.EventEditScopeFollowing, recurrenceID); err != nil {
+			t.Fatalf("%s: Unexpected error: %v", test.rrule, err)
+		}
+		if len(created) != 1 || len(updated) != 1 {
+			t.Fatalf("%s: Expected 1 create and 1 update, got %d and %d", test.rrule, len(created), len(updated))
+		}
+		if updated[0].RRule != test.previousRule || len(updated[0].ExDates) != 0 {
+			t.Errorf("%s: Unexpected previous series: %q %v", test.rrule, updated[0].RRule, updated[0].ExDates)
+		}
+		if created[0].RRule != test.followingRule || len(created[0].ExDates) != 1 {
+			t.Errorf("%s: Unexpected following series: %q %v", test.rrule, created[0].RRule, created[0].ExDates)
+		}
+	}
+}
+
+func TestEventService_UpdateEventOccurrences_WriteError(t *testing.T) {
+	jst, _ := time.LoadLocation("Asia/Tokyo")
+	var created, updated []domain.Event
+	repo := newRecurringEventTestRepository("FREQ=WEEKLY;COUNT=10", &created, &updated)
+	repo.UpdateSeriesFunc = func(series, event *domain.Event) error {
+		return errors.New("database error")
+	}
+	service := NewEventService(repo, nil)
+
+	for _, scope := range []string{domain.EventEditScopeThis, domain.EventEditScopeFollowing} {
+		event := &domain.Event{
+			ID:        1,
+			Title:     "定例会（会議室変更）",
+			StartDate: time.Date(2025, 1, 13, 14, 0, 0, 0, jst),
+			EndDate:   time.Date(2025, 1, 13, 15, 0, 0, 0, jst),
+			RRule:     "FREQ=WEEKLY;COUNT=10",
+		}
+		if err := service.UpdateEventOccurrences(event, scope, time.Date(2025, 1, 13, 10, 0, 0, 0, jst)); err == nil {
+			t.Errorf("%s: Expected error when the series cannot be updated", scope)
+		}
+		if event.ID != 1 {
+			t.Errorf("%s: Expected the event to be left unchanged, got ID %d", scope, event.ID)
+		}
+	}
+}
+
+func TestEventService_UpdateEventOccurrences_Invalid(t *testing.T) {
+	jst, _ := time.LoadLocation("Asia/Tokyo")
+	var created, updated []domain.Event
+	repo := newRecurringEventTestRepository("FREQ=WEEKLY;COUNT=10", &created, &updated)
+	service := NewEventService(repo, nil)
+
+	newEvent := func(id int) *domain.Event {
+		return &domain.Event{
+			ID:        id,
+			Title:     "定例会",
+			StartDate: time.Date(2025, 1, 13, 10, 0, 0, 0, jst),
+			EndDate:   time.Date(2025, 1, 13, 11, 0, 0, 0, jst),
+		}
+	}
+
+	// 繰り返しの回に当たらない日時・除外した回
+	for _, recurrenceID := range []time.Time{
+		time.Date(2025, 1, 14, 10, 0, 0, 0, jst),
+		time.Date(2025, 1, 27, 10, 0, 0, 0, jst),
+	} {
+		if err := service.UpdateEventOccurrences(newEvent(1), domain.EventEditScopeThis, recurrenceID); err != domain.ErrNotFound {
+			t.Errorf("%v: Expected ErrNotFound, got %v", recurrenceID, err)
+		}
+	}
+	if err := service.UpdateEventOccurrences(newEvent(99), domain.EventEditScopeThis, time.Date(2025, 1, 13, 10, 0, 0, 0, jst)); err != domain.ErrNotFound {
+		t.Errorf("Expected ErrNotFound for missing series, got %v", err)
+	}
+	if err := service.UpdateEventOccurrences(newEvent(1), "other", time.Date(2025, 1, 13, 10, 0, 0, 0, jst)); err != domain.ErrInvalidInput {
+		t.Errorf("Expected ErrInvalidInput for unknown scope, got %v", err)
+	}
+
+	repo.GetByIDFunc = func(id int) (*domain.Event, error) {
+		return newEvent(1), nil
+	}
+	if err := service.UpdateEventOccurrences(newEvent(1), domain.EventEditScopeThis, time.Date(2025, 1, 13, 10, 0, 0, 0, jst)); err != domain.ErrInvalidInput {
+		t.Errorf("Expected ErrInvalidInput for non-recurring event, got %v", err)
+	}
+	if len(created) != 0 || len(updated) != 0 {
+		t.Errorf("Expected no writes, got %d creates and %d updates", len(created), len(updated))
+	}
+}
+
+func TestEventService_CreateEvent_InvalidRRule(t *testing.T) {
+	service := NewEventService(&MockEventRepository{}, nil)
+
+	event := &domain.Event{
+		Title:     "定例会",
+		StartDate: time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, 1, 6, 11, 0, 0, 0, time.UTC),
+		RRule:     "FREQ=HOURLY",
+	}
+	if err := service.CreateEvent(event); err != domain.ErrInvalidInput {
+		t.Errorf("Expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestEventService_GetEventsByDateRange(t *testing.T) {
+	jst, _ := time.LoadLocation("Asia/Tokyo")
+	var created, updated []domain.Event
+	repo := newRecurringEventTestRepository("FREQ=WEEKLY;COUNT=10", &created, &updated)
+	repo.GetByDateRangeFunc = func(start, end time.Time) ([]domain.Event, error) {
+		series, err := repo.GetByIDFunc(1)
+		return []domain.Event{*series}, err
+	}
+	service := NewEventService(repo, nil)
+
+	// 1月27日の回は除いている
+	events, err := service.GetEventsByDateRange(time.Date(2025, 1, 10, 0, 0, 0, 0, jst), time.Date(2025, 1, 31, 23, 59, 59, 0, jst))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(events) != 2 || !events[0].StartDate.Equal(time.Date(2025, 1, 13, 10, 0, 0, 0, jst)) || !events[1].StartDate.Equal(time.Date(2025, 1, 20, 10, 0, 0, 0, jst)) {
+		t.Errorf("Expected the occurrences on 1/13 and 1/20, got %+v", events)
+	}
+
+	invalid := [][2]time.Time{
+		{time.Date(2025, 2, 1, 0, 0, 0, 0, jst), time.Date(2025, 1, 1, 0, 0, 0, 0, jst)},
+		{time.Date(2025, 1, 1, 0, 0, 0, 0, jst), time.Date(2026, 1, 2, 0, 0, 0, 0, jst)},
+	}
+	for _, r := range invalid {
+		if _, err := service.GetEventsByDateRange(r[0], r[1]); err != domain.ErrInvalidInput {
+			t.Errorf("%v - %v: Expected ErrInvalidInput, got %v", r[0], r[1], err)
+		}
+	}
+}
+
+func TestEventService_ListEvents(t *testing.T) {
+	// ID 1〜5 のイベントを title の順に並べたテーブルを、リポジトリと同じくキーセットで読む
+	titles := []string{"A", "B", "B", "C", "D"}
+	repo := &MockEventRepository{
+		ListFunc: func(opts domain.EventListOptions, after *domain.EventCursor) ([]domain.Event, error) {
+			if opts.Sort != domain.EventSortTitle {
+				t.Errorf("Expected sort by title, got %q", opts.Sort)
+			}
+			events := []domain.Event{}
+			for i, title := range titles {
+				id := i + 1
+				if after != nil && (title < after.Value || (title == after.Value && id <= after.ID)) {
+					continue
+				}
+				if len(events) < opts.Limit {
+					events = append(events, domain.Event{ID: id, Title: title, TimeZone: "UTC"})
+				}
+			}
+			return events, nil
+		},
+	}
+	service := NewEventService(repo, nil)
+
+	ids := []int{}
+	opts := domain.EventListOptions{Sort: domain.EventSortTitle, Limit: 2}
+	for pages := 0; ; pages++ {
+		if pages > 3 {
+			t.Fatal("Too many pages")
+		}
+		page, err := service.ListEvents(opts)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		for _, event := range page.Events {
+			ids = append(ids, event.ID)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		opts.Cursor = page.NextCursor
+	}
+
+	if len(ids) != 5 {
+		t.Fatalf("Expected 5 events across pages, got %v", ids)
+	}
+	for i, id := range ids {
+		if id != i+1 {
+			t.Errorf("Expected events in order 1..5, got %v", ids)
+			break
+		}
+	}
+}
+
+func TestEventService_ListEvents_Defaults(t *testing.T) {
+	var got domain.EventListOptions
+	repo := &MockEventRepository{
+		ListFunc: func(opts domain.EventListOptions, after *domain.EventCursor) ([]domain.Event, error) {
+			got = opts
+			return []domain.Event{}, nil
+		},
+	}
+	page, err := NewEventService(repo, nil).ListEvents(domain.EventListOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got.Sort != domain.EventSortStartDate || got.Limit != defaultEventPageSize+1 {
+		t.Errorf("Expected start_date and %d rows, got %q and %d", defaultEventPageSize+1, got.Sort, got.Limit)
+	}
+	if page.NextCursor != "" || page.Events == nil {
+		t.Errorf("Expected an empty last page, got %+v", page)
+	}
+}
+
+func TestEventService_ListEvents_Invalid(t *testing.T) {
+	service := NewEventService(&MockEventRepository{}, nil)
+	cursor := encodeEventCursor(domain.EventCursor{Sort: domain.EventSortStartDate, Value: "2025-01-06T01:00:00Z", ID: 1})
+
+	tests := []domain.EventListOptions{
+		{Sort: "created_at"},
+		{Limit: -1},
+		{Limit: maxEventPageSize + 1},
+		{Cursor: "not a cursor"},
+		{Cursor: encodeEventCursor(domain.EventCursor{Sort: domain.EventSortStartDate, Value: "yesterday", ID: 1})},
+		// 並び順の異なる一覧のカーソル
+		{Sort: domain.EventSortTitle, Cursor: cursor},
+		{Desc: true, Cursor: cursor},
+	}
+	for _, opts := range tests {
+		if _, err := service.ListEvents(opts); err != domain.ErrInvalidInput {
+			t.Errorf("%+v: Expected ErrInvalidInput, got %v", opts, err)
+		}
+	}
+
+	if _, err := service.ListEvents(domain.EventListOptions{Cursor: cursor}); err != nil {
+		t.Errorf("Expected the cursor to be accepted, got %v", err)
+	}
+}
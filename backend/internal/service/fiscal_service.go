@@ -0,0 +1,284 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// DefaultFiscalDefinition 年度の定義が指定されなかった場合に使用する定義
+const DefaultFiscalDefinition = "fiscal"
+
+// customFiscalDefinition 開始月だけを指定した年度の定義のID
+const customFiscalDefinition = "custom"
+
+// defaultFiscalDefinitions 組み込みの年度の定義
+var defaultFiscalDefinitions = []domain.FiscalYearDefinition{
+	{
+		ID:         "fiscal",
+		Name:       "会計年度",
+		StartMonth: 4,
+		Terms: []domain.FiscalTermDefinition{
+			{Name: "上期", StartMonth: 4, StartDay: 1},
+			{Name: "下期", StartMonth: 10, StartDay: 1},
+		},
+	},
+	{
+		ID:         "school",
+		Name:       "学校年度（3学期制）",
+		StartMonth: 4,
+		Terms: []domain.FiscalTermDefinition{
+			{Name: "1学期", StartMonth: 4, StartDay: 1},
+			{Name: "2学期", StartMonth: 9, StartDay: 1},
+			{Name: "3学期", StartMonth: 1, StartDay: 1},
+		},
+	},
+	{
+		ID:         "school-2term",
+		Name:       "学校年度（2学期制）",
+		StartMonth: 4,
+		Terms: []domain.FiscalTermDefinition{
+			{Name: "前期", StartMonth: 4, StartDay: 1},
+			{Name: "後期", StartMonth: 10, StartDay: 1},
+		},
+	},
+	{
+		ID:         "calendar",
+		Name:       "暦年",
+		StartMonth: 1,
+		Terms:      []domain.FiscalTermDefinition{},
+	},
+}
+
+type FiscalService struct {
+	calendar    MonthCalendarInterface
+	definitions map[string]domain.FiscalYearDefinition
+}
+
+// MonthCalendarInterface 年度のカレンダー表示に使う月次カレンダーの取得元
+type MonthCalendarInterface interface {
+	GetMonthCalendars(year, month, months int, opts domain.CalendarOptions) ([]domain.Calendar, error)
+}
+
+func NewFiscalService(calendar MonthCalendarInterface) *FiscalService {
+	s := &FiscalService{
+		calendar:    calendar,
+		definitions: make(map[string]domain.FiscalYearDefinition),
+	}
+	for _, def := range defaultFiscalDefinitions {
+		s.RegisterDefinition(def)
+	}
+	return s
+}
+
+// RegisterDefinition 年度の定義を登録（同じIDの定義は置き換える）
+// 学期・期は年度の開始日から順に並べ、最初の期は年度の開始日から始まること
+func (s *FiscalService) RegisterDefinition(def domain.FiscalYearDefinition) error {
+	if def.ID == "" || def.StartMonth < 1 || def.StartMonth > 12 {
+		return domain.ErrInvalidInput
+	}
+
+	// 2001年度（閏年を含まない）で期の並びを確かめる
+	previous := time.Time{}
+	for i, term := range def.Terms {
+		start, ok := termStart(2001, def.StartMonth, term)
+		if !ok || (i == 0 && start.Day() != 1) || (i == 0 && int(start.Month()) != def.StartMonth) || !start.After(previous) {
+			return domain.ErrInvalidInput
+		}
+		previous = start
+	}
+
+	if def.Terms == nil {
+		def.Terms = []domain.FiscalTermDefinition{}
+	}
+	s.definitions[def.ID] = def
+	return nil
+}
+
+// LoadFiscalDefinitionsFile 年度の定義の一覧を記述した JSON ファイルを読み込む
+func LoadFiscalDefinitionsFile(path string) ([]domain.FiscalYearDefinition, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ParseFiscalDefinitions(f)
+}
+
+// ParseFiscalDefinitions 年度の定義の JSON 配列（GET /api/fiscal/definitions と同じ形式）を解析
+// 定義の内容の検証は RegisterDefinition で行う
+func ParseFiscalDefinitions(r io.Reader) ([]domain.FiscalYearDefinition, error) {
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+
+	var definitions []domain.FiscalYearDefinition
+	if err := decoder.Decode(&definitions); err != nil {
+		return nil, fmt.Errorf("fiscal year definitions: %w", err)
+	}
+	return definitions, nil
+}
+
+// GetFiscalDefinitions 登録されている年度の定義の一覧（ID順）
+func (s *FiscalService) GetFiscalDefinitions() []domain.FiscalYearDefinition {
+	definitions := make([]domain.FiscalYearDefinition, 0, len(s.definitions))
+	for _, def := range s.definitions {
+		definitions = append(definitions, def)
+	}
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].ID < definitions[j].ID
+	})
+	return definitions
+}
+
+// GetFiscalDate 指定日の年度・上期下期・四半期・年度内の月と学期・期を求める
+func (s *FiscalService) GetFiscalDate(date time.Time, opts domain.FiscalOptions) (*domain.FiscalDate, error) {
+	def, err := s.resolveDefinition(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	date = truncateToDate(date)
+	fiscalYear := date.Year()
+	if int(date.Month()) < def.StartMonth {
+		fiscalYear--
+	}
+	fiscalMonth := (int(date.Month())-def.StartMonth+12)%12 + 1
+	quarter := (fiscalMonth-1)/3 + 1
+
+	yearStart := fiscalYearStart(fiscalYear, def.StartMonth)
+	quarterStart := yearStart.AddDate(0, (quarter-1)*3, 0)
+
+	result := &domain.FiscalDate{
+		Date:        date,
+		Definition:  def.ID,
+		FiscalYear:  fiscalYear,
+		Name:        fiscalYearName(fiscalYear),
+		Half:        (fiscalMonth-1)/6 + 1,
+		Quarter:     quarter,
+		FiscalMonth: fiscalMonth,
+		Year:        domain.FiscalPeriod{Name: fiscalYearName(fiscalYear), Start: yearStart, End: yearStart.AddDate(1, 0, -1)},
+		QuarterSpan: domain.FiscalPeriod{Name: quarterName(quarter), Start: quarterStart, End: quarterStart.AddDate(0, 3, -1)},
+	}
+	for _, term := range fiscalTerms(fiscalYear, def) {
+		if !date.Before(term.Start) && !date.After(term.End) {
+			term := term
+			result.Term = &term
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// GetFiscalYearCalendar 指定年度の開始月から12か月分のカレンダーを取得
+func (s *FiscalService) GetFiscalYearCalendar(fiscalYear int, opts domain.FiscalOptions, calendarOpts domain.CalendarOptions) (*domain.FiscalYearCalendar, error) {
+	def, err := s.resolveDefinition(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	start := fiscalYearStart(fiscalYear, def.StartMonth)
+	result := &domain.FiscalYearCalendar{
+		Definition: def.ID,
+		FiscalYear: fiscalYear,
+		Name:       fiscalYearName(fiscalYear),
+		Start:      start,
+		End:        start.AddDate(1, 0, -1),
+		Quarters:   []domain.FiscalPeriod{},
+		Terms:      fiscalTerms(fiscalYear, def),
+		Months:     []domain.Calendar{},
+	}
+
+	for quarter := 1; quarter <= 4; quarter++ {
+		quarterStart := start.AddDate(0, (quarter-1)*3, 0)
+		result.Quarters = append(result.Quarters, domain.FiscalPeriod{
+			Name:  quarterName(quarter),
+			Start: quarterStart,
+			End:   quarterStart.AddDate(0, 3, -1),
+		})
+	}
+
+	months, err := s.calendar.GetMonthCalendars(start.Year(), int(start.Month()), 12, calendarOpts)
+	if err != nil {
+		return nil, err
+	}
+	result.Months = months
+
+	return result, nil
+}
+
+// resolveDefinition 指定された年度の定義を取得
+// 開始月のみが指定された場合は学期・期のない年度の定義を作る
+func (s *FiscalService) resolveDefinition(opts domain.FiscalOptions) (domain.FiscalYearDefinition, error) {
+	if opts.StartMonth != 0 {
+		if opts.Definition != "" || opts.StartMonth < 1 || opts.StartMonth > 12 {
+			return domain.FiscalYearDefinition{}, domain.ErrInvalidInput
+		}
+		return domain.FiscalYearDefinition{
+			ID:         customFiscalDefinition,
+			Name:       fmt.Sprintf("%d月始まり", opts.StartMonth),
+			StartMonth: opts.StartMonth,
+			Terms:      []domain.FiscalTermDefinition{},
+		}, nil
+	}
+
+	id := opts.Definition
+	if id == "" {
+		id = DefaultFiscalDefinition
+	}
+	def, ok := s.definitions[id]
+	if !ok {
+		return domain.FiscalYearDefinition{}, domain.ErrInvalidInput
+	}
+	return def, nil
+}
+
+// fiscalYearStart 年度の開始日
+func fiscalYearStart(fiscalYear, startMonth int) time.Time {
+	return time.Date(fiscalYear, time.Month(startMonth), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// fiscalYearName 年度の呼び名（例: 2025年度）
+func fiscalYearName(fiscalYear int) string {
+	return fmt.Sprintf("%d年度", fiscalYear)
+}
+
+// quarterName 四半期の呼び名
+func quarterName(quarter int) string {
+	return fmt.Sprintf("第%d四半期", quarter)
+}
+
+// termStart 指定年度の学期・期の開始日
+// 年度の開始月より前の月に始まる期は翌年の日付になる
+func termStart(fiscalYear, startMonth int, term domain.FiscalTermDefinition) (time.Time, bool) {
+	year := fiscalYear
+	if term.StartMonth < startMonth {
+		year++
+	}
+	start := time.Date(year, time.Month(term.StartMonth), term.StartDay, 0, 0, 0, 0, time.UTC)
+	if term.StartMonth < 1 || term.StartMonth > 12 || start.Day() != term.StartDay {
+		return time.Time{}, false
+	}
+	return start, true
+}
+
+// fiscalTerms 指定年度の学期・期の期間（各期は次の期の前日まで、最後の期は年度末まで）
+func fiscalTerms(fiscalYear int, def domain.FiscalYearDefinition) []domain.FiscalPeriod {
+	yearEnd := fiscalYearStart(fiscalYear, def.StartMonth).AddDate(1, 0, -1)
+
+	terms := []domain.FiscalPeriod{}
+	for _, term := range def.Terms {
+		start, _ := termStart(fiscalYear, def.StartMonth, term)
+		if len(terms) > 0 {
+			terms[len(terms)-1].End = start.AddDate(0, 0, -1)
+		}
+		terms = append(terms, domain.FiscalPeriod{Name: term.Name, Start: start, End: yearEnd})
+	}
+	return terms
+}
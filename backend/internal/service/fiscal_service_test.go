@@ -0,0 +1,253 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+func TestNewFiscalService(t *testing.T) {
+	service := NewFiscalService(NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{}))
+
+	if service == nil {
+		t.Error("NewFiscalService should return a non-nil service")
+	}
+	if len(service.GetFiscalDefinitions()) != len(defaultFiscalDefinitions) {
+		t.Errorf("Expected %d definitions, got %d", len(defaultFiscalDefinitions), len(service.GetFiscalDefinitions()))
+	}
+}
+
+func TestFiscalService_GetFiscalDate(t *testing.T) {
+	service := NewFiscalService(NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{}))
+
+	tests := []struct {
+		date        time.Time
+		opts        domain.FiscalOptions
+		fiscalYear  int
+		half        int
+		quarter     int
+		fiscalMonth int
+		term        string
+	}{
+		{time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), domain.FiscalOptions{}, 2025, 1, 1, 1, "上期"},
+		{time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC), domain.FiscalOptions{}, 2025, 2, 3, 9, "下期"},
+		{time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC), domain.FiscalOptions{}, 2025, 2, 4, 12, "下期"},
+		{time.Date(2025, 8, 20, 0, 0, 0, 0, time.UTC), domain.FiscalOptions{Definition: "school"}, 2025, 1, 2, 5, "1学期"},
+		{time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), domain.FiscalOptions{Definition: "school"}, 2025, 1, 2, 6, "2学期"},
+		{time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC), domain.FiscalOptions{Definition: "school"}, 2025, 2, 4, 11, "3学期"},
+		{time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC), domain.FiscalOptions{Definition: "calendar"}, 2025, 1, 1, 2, ""},
+		// 10月始まり（米国連邦政府の会計年度と同じ区切り）
+		{time.Date(2025, 9, 30, 0, 0, 0, 0, time.UTC), domain.FiscalOptions{StartMonth: 10}, 2024, 2, 4, 12, ""},
+		{time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC), domain.FiscalOptions{StartMonth: 10}, 2025, 1, 1, 1, ""},
+	}
+
+	for _, test := range tests {
+		result, err := service.GetFiscalDate(test.date, test.opts)
+		if err != nil {
+			t.Fatalf("%s: GetFiscalDate should not return error: %v", test.date.Format("2006-01-02"), err)
+		}
+		if result.FiscalYear != test.fiscalYear || result.Half != test.half || result.Quarter != test.quarter || result.FiscalMonth != test.fiscalMonth {
+			t.Errorf("%s (%+v): Expected FY%d H%d Q%d M%d, got FY%d H%d Q%d M%d", test.date.Format("2006-01-02"), test.opts,
+				test.fiscalYear, test.half, test.quarter, test.fiscalMonth,
+				result.FiscalYear, result.Half, result.Quarter, result.FiscalMonth)
+		}
+
+		term := ""
+		if result.Term != nil {
+			term = result.Term.Name
+		}
+		if term != test.term {
+			t.Errorf("%s (%+v): Expected term %q, got %q", test.date.Format("2006-01-02"), test.opts, test.term, term)
+		}
+	}
+
+	result, _ := service.GetFiscalDate(time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC), domain.FiscalOptions{})
+	if result.Name != "2025年度" {
+		t.Errorf("Expected 2025年度, got %s", result.Name)
+	}
+	if !result.QuarterSpan.Start.Equal(time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)) || !result.QuarterSpan.End.Equal(time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected quarter span: %+v", result.QuarterSpan)
+	}
+	if !result.Year.End.Equal(time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected fiscal year end 2026-03-31, got %s", result.Year.End.Format("2006-01-02"))
+	}
+}
+
+func TestFiscalService_GetFiscalDate_InvalidOptions(t *testing.T) {
+	service := NewFiscalService(NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{}))
+	date := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, opts := range []domain.FiscalOptions{
+		{Definition: "unknown"},
+		{StartMonth: 13},
+		{StartMonth: -1},
+		{Definition: "school", StartMonth: 4},
+	} {
+		if _, err := service.GetFiscalDate(date, opts); err != domain.ErrInvalidInput {
+			t.Errorf("%+v: Expected ErrInvalidInput, got %v", opts, err)
+		}
+	}
+}
+
+func TestFiscalService_RegisterDefinition(t *testing.T) {
+	service := NewFiscalService(NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{}))
+
+	quarterly := domain.FiscalYearDefinition{
+		ID:         "retail",
+		Name:       "2月始まりの事業年度",
+		StartMonth: 2,
+		Terms: []domain.FiscalTermDefinition{
+			{Name: "春夏", StartMonth: 2, StartDay: 1},
+			{Name: "秋冬", StartMonth: 8, StartDay: 1},
+		},
+	}
+	if err := service.RegisterDefinition(quarterly); err != nil {
+		t.Fatalf("RegisterDefinition should not return error: %v", err)
+	}
+
+	result, err := service.GetFiscalDate(time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC), domain.FiscalOptions{Definition: "retail"})
+	if err != nil {
+		t.Fatalf("GetFiscalDate should not return error: %v", err)
+	}
+	if result.FiscalYear != 2025 || result.Term == nil || result.Term.Name != "秋冬" {
+		t.Errorf("Expected 2025年度 秋冬, got %+v", result)
+	}
+
+	invalid := []domain.FiscalYearDefinition{
+		{ID: "", StartMonth: 4},
+		{ID: "bad-month", StartMonth: 0},
+		// 最初の期が年度の開始日から始まらない
+		{ID: "bad-first", StartMonth: 4, Terms: []domain.FiscalTermDefinition{{Name: "1", StartMonth: 5, StartDay: 1}}},
+		// 期の順序が逆
+		{ID: "bad-order", StartMonth: 4, Terms: []domain.FiscalTermDefinition{
+			{Name: "1", StartMonth: 4, StartDay: 1}, {Name: "3", StartMonth: 1, StartDay: 1}, {Name: "2", StartMonth: 9, StartDay: 1},
+		}},
+		{ID: "bad-day", StartMonth: 4, Terms: []domain.FiscalTermDefinition{{Name: "1", StartMonth: 4, StartDay: 31}}},
+	}
+	for _, def := range invalid {
+		if err := service.RegisterDefinition(def); err != domain.ErrInvalidInput {
+			t.Errorf("%s: Expected ErrInvalidInput, got %v", def.ID, err)
+		}
+	}
+}
+
+func TestParseFiscalDefinitions(t *testing.T) {
+	input := `[
+		{"id": "retail", "name": "2月始まりの事業年度", "start_month": 2,
+		 "terms": [{"name": "春夏", "start_month": 2, "start_day": 1}, {"name": "秋冬", "start_month": 8, "start_day": 1}]},
+		{"id": "fiscal", "name": "会計年度（7月始まり）", "start_month": 7}
+	]`
+	definitions, err := ParseFiscalDefinitions(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseFiscalDefinitions should not return error: %v", err)
+	}
+	if len(definitions) != 2 || definitions[0].ID != "retail" || len(definitions[0].Terms) != 2 || definitions[1].StartMonth != 7 {
+		t.Fatalf("Unexpected definitions: %+v", definitions)
+	}
+
+	service := NewFiscalService(NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{}))
+	for _, def := range definitions {
+		if err := service.RegisterDefinition(def); err != nil {
+			t.Fatalf("RegisterDefinition(%s) should not return error: %v", def.ID, err)
+		}
+	}
+	// 組み込みの定義は同じIDの定義で置き換わる
+	result, err := service.GetFiscalDate(time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC), domain.FiscalOptions{})
+	if err != nil {
+		t.Fatalf("GetFiscalDate should not return error: %v", err)
+	}
+	if result.FiscalYear != 2024 || result.Term != nil {
+		t.Errorf("Expected 2024年度 without terms, got %+v", result)
+	}
+
+	for _, input := range []string{`{"id": "fiscal"}`, `[{"id": "x", "start": 4}]`, `[`} {
+		if _, err := ParseFiscalDefinitions(strings.NewReader(input)); err == nil {
+			t.Errorf("%s: Expected error", input)
+		}
+	}
+}
+
+func TestFiscalService_GetFiscalYearCalendar(t *testing.T) {
+	service := NewFiscalService(NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{}))
+
+	result, err := service.GetFiscalYearCalendar(2025, domain.FiscalOptions{Definition: "school"}, domain.CalendarOptions{})
+	if err != nil {
+		t.Fatalf("GetFiscalYearCalendar should not return error: %v", err)
+	}
+
+	if len(result.Months) != 12 {
+		t.Fatalf("Expected 12 months, got %d", len(result.Months))
+	}
+	if result.Months[0].Year != 2025 || result.Months[0].Month != 4 || result.Months[11].Year != 2026 || result.Months[11].Month != 3 {
+		t.Errorf("Expected 2025/4 - 2026/3, got %d/%d - %d/%d",
+			result.Months[0].Year, result.Months[0].Month, result.Months[11].Year, result.Months[11].Month)
+	}
+	if len(result.Quarters) != 4 || !result.Quarters[3].End.Equal(time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected quarters: %+v", result.Quarters)
+	}
+
+	expectedTerms := []struct {
+		name       string
+		start, end time.Time
+	}{
+		{"1学期", time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 8, 31, 0, 0, 0, 0, time.UTC)},
+		{"2学期", time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"3学期", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)},
+	}
+	if len(result.Terms) != len(expectedTerms) {
+		t.Fatalf("Expected %d terms, got %d", len(expectedTerms), len(result.Terms))
+	}
+	for i, expected := range expectedTerms {
+		term := result.Terms[i]
+		if term.Name != expected.name || !term.Start.Equal(expected.start) || !term.End.Equal(expected.end) {
+			t.Errorf("Expected %s %s - %s, got %s %s - %s", expected.name,
+				expected.start.Format("2006-01-02"), expected.end.Format("2006-01-02"),
+				term.Name, term.Start.Format("2006-01-02"), term.End.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestFiscalService_GetFiscalYearCalendar_SingleFetch(t *testing.T) {
+	calls := 0
+	eventRepo := &MockEventRepository{
+		GetByDateRangeFunc: func(start, end time.Time) ([]domain.Event, error) {
+			calls++
+			return []domain.Event{}, nil
+		},
+	}
+	calendarService := NewCalendarService(&MockCustomHolidayRepository{}, eventRepo)
+	service := NewFiscalService(calendarService)
+
+	opts := domain.CalendarOptions{Grid: true, WeekStart: time.Monday}
+	result, err := service.GetFiscalYearCalendar(2025, domain.FiscalOptions{}, opts)
+	if err != nil {
+		t.Fatalf("GetFiscalYearCalendar should not return error: %v", err)
+	}
+	// 年度全体のイベントを一度で取得する
+	if calls != 1 {
+		t.Errorf("Expected events to be fetched once, got %d", calls)
+	}
+
+	// 各月は月次カレンダーと同じ日付・週になる
+	for _, month := range result.Months {
+		expected, err := calendarService.GetCalendar(month.Year, month.Month, opts)
+		if err != nil {
+			t.Fatalf("GetCalendar should not return error: %v", err)
+		}
+		if len(month.Days) != len(expected.Days) || len(month.Weeks) != len(expected.Weeks) {
+			t.Errorf("%d/%d: Expected %d days in %d weeks, got %d days in %d weeks", month.Year, month.Month,
+				len(expected.Days), len(expected.Weeks), len(month.Days), len(month.Weeks))
+			continue
+		}
+		for i, week := range month.Weeks {
+			for j, day := range week.Days {
+				want := expected.Weeks[i].Days[j]
+				if !day.Date.Equal(want.Date) || day.OutsideMonth != want.OutsideMonth || day.Holiday != want.Holiday {
+					t.Errorf("%d/%d: %s differs from month view", month.Year, month.Month, day.Date.Format("2006-01-02"))
+				}
+			}
+		}
+	}
+}
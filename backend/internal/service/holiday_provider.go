@@ -0,0 +1,128 @@
+package service
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// DefaultRegion 地域が指定されなかった場合に使用する国・地域コード
+const DefaultRegion = "JP"
+
+// HolidayProvider 国・地域ごとの祝日を提供するインターフェース
+type HolidayProvider interface {
+	// Region ISO 3166-1 alpha-2 形式の国・地域コード
+	Region() string
+	// Holidays 指定年の祝日一覧を日付順で返す
+	Holidays(year int) []domain.Holiday
+}
+
+// HolidayRegistry 国・地域コードから祝日プロバイダーを引くためのレジストリ
+type HolidayRegistry struct {
+	providers map[string]HolidayProvider
+}
+
+// NewHolidayRegistry 祝日プロバイダーを登録したレジストリを作成
+func NewHolidayRegistry(providers ...HolidayProvider) *HolidayRegistry {
+	r := &HolidayRegistry{providers: make(map[string]HolidayProvider)}
+	for _, p := range providers {
+		r.Register(p)
+	}
+	return r
+}
+
+// Register 祝日プロバイダーを登録（同じ地域のプロバイダーは置き換える）
+func (r *HolidayRegistry) Register(p HolidayProvider) {
+	r.providers[strings.ToUpper(p.Region())] = p
+}
+
+// Get 国・地域コードに対応する祝日プロバイダーを取得
+func (r *HolidayRegistry) Get(region string) (HolidayProvider, bool) {
+	p, ok := r.providers[strings.ToUpper(region)]
+	return p, ok
+}
+
+// Regions 登録されている国・地域コードの一覧
+func (r *HolidayRegistry) Regions() []string {
+	regions := make([]string, 0, len(r.providers))
+	for region := range r.providers {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+// japanHolidayProvider 日本の祝日（国民の祝日・振替休日・国民の休日）
+type japanHolidayProvider struct {
+	calendar *CalendarService
+}
+
+func (p *japanHolidayProvider) Region() string {
+	return DefaultRegion
+}
+
+func (p *japanHolidayProvider) Holidays(year int) []domain.Holiday {
+	return p.calendar.GetHolidays(year)
+}
+
+// GetHolidaysForRegions 指定した国・地域の祝日をまとめて日付順に取得
+// 地域が空の場合は日本の祝日を返す。未登録の地域が含まれる場合は ErrInvalidInput を返す
+func (s *CalendarService) GetHolidaysForRegions(year int, regions []string) ([]domain.Holiday, error) {
+	if err := validateYear(year); err != nil {
+		return nil, err
+	}
+	return s.holidaysForRegions(year, regions)
+}
+
+// holidaysForRegions 年の範囲を確認せずに指定年の指定地域の祝日を取得
+// カレンダーのグリッドが対応範囲の端で前後の年にはみ出す場合に使う
+func (s *CalendarService) holidaysForRegions(year int, regions []string) ([]domain.Holiday, error) {
+	providers, err := s.resolveHolidayProviders(regions)
+	if err != nil {
+		return nil, err
+	}
+
+	holidays := []domain.Holiday{}
+	for _, p := range providers {
+		for _, h := range p.Holidays(year) {
+			h.Region = p.Region()
+			holidays = append(holidays, h)
+		}
+	}
+
+	sort.SliceStable(holidays, func(i, j int) bool {
+		return holidays[i].Date.Before(holidays[j].Date)
+	})
+
+	return holidays, nil
+}
+
+// resolveHolidayProviders 国・地域コードの一覧を重複を除いたプロバイダーの一覧に変換
+func (s *CalendarService) resolveHolidayProviders(regions []string) ([]HolidayProvider, error) {
+	if len(regions) == 0 {
+		regions = []string{DefaultRegion}
+	}
+
+	seen := make(map[string]bool)
+	providers := []HolidayProvider{}
+	for _, region := range regions {
+		region = strings.ToUpper(strings.TrimSpace(region))
+		if region == "" || seen[region] {
+			continue
+		}
+		seen[region] = true
+
+		p, ok := s.holidays.Get(region)
+		if !ok {
+			return nil, domain.ErrInvalidInput
+		}
+		providers = append(providers, p)
+	}
+
+	if len(providers) == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return providers, nil
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+func TestHolidayRegistry(t *testing.T) {
+	registry := NewHolidayRegistry(&usHolidayProvider{}, &taiwanHolidayProvider{})
+
+	if _, ok := registry.Get("us"); !ok {
+		t.Error("Expected region lookup to be case-insensitive")
+	}
+	if _, ok := registry.Get("JP"); ok {
+		t.Error("Expected unregistered region JP to be missing")
+	}
+
+	regions := registry.Regions()
+	if len(regions) != 2 || regions[0] != "TW" || regions[1] != "US" {
+		t.Errorf("Expected regions [TW US], got %v", regions)
+	}
+}
+
+func TestHolidayProviders_Type(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+
+	for _, region := range service.HolidayRegions() {
+		provider, _ := service.holidays.Get(region)
+		for _, h := range provider.Holidays(2025) {
+			if h.Type != domain.HolidayTypeNational {
+				t.Errorf("%s %s %v: Expected type %q, got %q", region, h.Name, h.Date, domain.HolidayTypeNational, h.Type)
+			}
+		}
+	}
+}
+
+func TestCalendarService_HolidayRegions(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+
+	regions := service.HolidayRegions()
+	expected := []string{"JP", "TW", "US"}
+	if len(regions) != len(expected) {
+		t.Fatalf("Expected regions %v, got %v", expected, regions)
+	}
+	for i := range expected {
+		if regions[i] != expected[i] {
+			t.Errorf("Expected regions %v, got %v", expected, regions)
+		}
+	}
+}
+
+func TestUSHolidayProvider(t *testing.T) {
+	provider := &usHolidayProvider{}
+
+	tests := []struct {
+		year     int
+		month    int
+		day      int
+		expected string
+	}{
+		{2025, 1, 20, "Martin Luther King Jr. Day"},
+		{2025, 2, 17, "Washington's Birthday"},
+		{2025, 5, 26, "Memorial Day"},
+		{2025, 9, 1, "Labor Day"},
+		{2025, 11, 27, "Thanksgiving Day"},
+		// 日曜日の祝日は翌月曜日に振替
+		{2023, 1, 2, "New Year's Day (Observed)"},
+		// 土曜日の祝日は前日の金曜日に振替
+		{2026, 7, 3, "Independence Day (Observed)"},
+		// 翌年の元日が土曜日の場合は12月31日に振替
+		{2021, 12, 31, "New Year's Day (Observed)"},
+	}
+
+	for _, test := range tests {
+		name, _ := findHoliday(provider.Holidays(test.year), test.year, test.month, test.day)
+		if name != test.expected {
+			t.Errorf("%d-%02d-%02d: Expected %q, got %q", test.year, test.month, test.day, test.expected, name)
+		}
+	}
+
+	if name, ok := findHoliday(provider.Holidays(2020), 2021, 1, 1); ok {
+		t.Errorf("Expected holidays of 2020 to exclude 2021-01-01, got %q", name)
+	}
+	if name, ok := findHoliday(provider.Holidays(2020), 2020, 6, 19); ok {
+		t.Errorf("Expected no Juneteenth before 2021, got %q", name)
+	}
+}
+
+func TestTaiwanHolidayProvider(t *testing.T) {
+	provider := &taiwanHolidayProvider{}
+
+	tests := []struct {
+		year     int
+		month    int
+		day      int
+		expected string
+	}{
+		{2025, 1, 28, "農曆除夕"},
+		{2025, 1, 29, "春節"},
+		{2025, 1, 31, "春節"},
+		{2025, 2, 28, "和平紀念日"},
+		// 兒童節と民族掃墓節が重なるため兒童節は前日
+		{2025, 4, 3, "兒童節"},
+		{2025, 4, 4, "民族掃墓節"},
+		{2025, 5, 30, "補假"},
+		{2025, 5, 31, "端午節"},
+		{2025, 10, 6, "中秋節"},
+		{2025, 10, 10, "國慶日"},
+		{2024, 2, 10, "春節"},
+		// 2024年の春節は土日を含むため連休明けに補假
+		{2024, 2, 13, "補假"},
+		{2024, 2, 14, "補假"},
+		{2024, 9, 17, "中秋節"},
+		// 2024年は木曜日に重なるため兒童節は翌日の金曜日
+		{2024, 4, 4, "民族掃墓節"},
+		{2024, 4, 5, "兒童節"},
+	}
+
+	for _, test := range tests {
+		name, _ := findHoliday(provider.Holidays(test.year), test.year, test.month, test.day)
+		if name != test.expected {
+			t.Errorf("%d-%02d-%02d: Expected %q, got %q", test.year, test.month, test.day, test.expected, name)
+		}
+	}
+
+	if name, ok := findHoliday(provider.Holidays(2024), 2024, 4, 3); ok {
+		t.Errorf("Expected no holiday on 2024-04-03, got %q", name)
+	}
+	if name, ok := findHoliday(provider.Holidays(2024), 2024, 5, 1); ok {
+		t.Errorf("Expected no 勞動節 before 2025, got %q", name)
+	}
+}
+
+func TestCalendarService_GetHolidaysForRegions(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+
+	holidays, err := service.GetHolidaysForRegions(2025, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(holidays) != len(service.GetHolidays(2025)) {
+		t.Errorf("Expected default region to return Japanese holidays only, got %d holidays", len(holidays))
+	}
+	for _, h := range holidays {
+		if h.Region != "JP" {
+			t.Errorf("Expected region JP, got %q", h.Region)
+		}
+	}
+
+	merged, err := service.GetHolidaysForRegions(2025, []string{"jp", "US", "JP"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(merged) != len(service.GetHolidays(2025))+len((&usHolidayProvider{}).Holidays(2025)) {
+		t.Errorf("Expected duplicated regions to be ignored, got %d holidays", len(merged))
+	}
+	for i := 1; i < len(merged); i++ {
+		if merged[i].Date.Before(merged[i-1].Date) {
+			t.Errorf("Holidays are not sorted at index %d", i)
+		}
+	}
+
+	// 元日は日本・米国の両方の祝日
+	newYear := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	regions := []string{}
+	for _, h := range merged {
+		if h.Date.Equal(newYear) {
+			regions = append(regions, h.Region)
+		}
+	}
+	if len(regions) != 2 || regions[0] != "JP" || regions[1] != "US" {
+		t.Errorf("Expected 2025-01-01 in regions [JP US], got %v", regions)
+	}
+
+	if _, err := service.GetHolidaysForRegions(2025, []string{"JP", "XX"}); err != domain.ErrInvalidInput {
+		t.Errorf("Expected ErrInvalidInput for unknown region, got %v", err)
+	}
+	for _, year := range []int{domain.MinSupportedYear - 1, domain.MaxSupportedYear + 1, 2300} {
+		if _, err := service.GetHolidaysForRegions(year, nil); err != domain.ErrInvalidInput {
+			t.Errorf("GetHolidaysForRegions(%d): Expected ErrInvalidInput, got %v", year, err)
+		}
+	}
+}
+
+func TestCalendarService_GetCalendar_Regions(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+
+	calendar, err := service.GetCalendar(2025, 7, domain.CalendarOptions{Regions: []string{"JP", "US"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// 7月4日は米国のみの祝日
+	july4 := calendar.Days[3]
+	if !july4.IsHoliday || july4.Holiday != "Independence Day" {
+		t.Errorf("Expected 2025-07-04 to be Independence Day, got %q", july4.Holiday)
+	}
+	if len(july4.Holidays) != 1 || july4.Holidays[0].Region != "US" {
+		t.Errorf("Expected 1 US holiday on 2025-07-04, got %v", july4.Holidays)
+	}
+
+	// 海の日は日本の祝日
+	umi := calendar.Days[20]
+	if !umi.IsHoliday || umi.Holiday != "海の日" || umi.Holidays[0].Region != "JP" {
+		t.Errorf("Expected 2025-07-21 to be 海の日 in JP, got %v", umi.Holidays)
+	}
+
+	if calendar.Days[0].Holidays == nil {
+		t.Error("Expected Holidays to be an empty slice on non-holidays")
+	}
+
+	if _, err := service.GetCalendar(2025, 7, domain.CalendarOptions{Regions: []string{"XX"}}); err != domain.ErrInvalidInput {
+		t.Errorf("Expected ErrInvalidInput for unknown region, got %v", err)
+	}
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"sort"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// taipei 台湾の農暦・節気計算に用いるタイムゾーン（UTC+8）
+var taipei = time.FixedZone("CST", 8*60*60)
+
+// taiwanHolidayRules 台湾の国定假日のうち、新暦で日付が決まるもの
+var taiwanHolidayRules = []holidayRule{
+	{name: "開國紀念日", from: 1949, date: fixedDate(time.January, 1)},
+	{name: "和平紀念日", from: 1997, date: fixedDate(time.February, 28)},
+	{name: "勞動節", from: 2025, date: fixedDate(time.May, 1)},
+	{name: "教師節", from: 2025, date: fixedDate(time.September, 28)},
+	{name: "國慶日", from: 1949, date: fixedDate(time.October, 10)},
+	{name: "臺灣光復節", from: 2025, date: fixedDate(time.October, 25)},
+	{name: "行憲紀念日", from: 2025, date: fixedDate(time.December, 25)},
+}
+
+// taiwanLunarHolidays 台湾の国定假日のうち、農暦で日付が決まるもの
+var taiwanLunarHolidays = []struct {
+	name  string
+	month int
+	day   int
+}{
+	{name: "端午節", month: 5, day: 5},
+	{name: "中秋節", month: 8, day: 15},
+}
+
+// taiwanHolidayProvider 台湾の国定假日（農暦の祝日を含む）
+type taiwanHolidayProvider struct{}
+
+func (p *taiwanHolidayProvider) Region() string {
+	return "TW"
+}
+
+// Holidays 国定假日と補假を返す
+// 土曜日の祝日は前日の金曜日、日曜日の祝日は翌日の月曜日を補假とする。
+// 春節（除夕〜初三）に週末が含まれる場合は、連休の翌日以降に同じ日数の補假を設ける
+func (p *taiwanHolidayProvider) Holidays(year int) []domain.Holiday {
+	holidays := applyHolidayRules(taiwanHolidayRules, year)
+
+	springFestival := p.springFestival(year)
+	holidays = append(holidays, springFestival...)
+
+	tombSweeping := solarTermDayIn(year, 15, taipei)
+	childrensDay := time.Date(year, time.April, 4, 0, 0, 0, 0, time.UTC)
+	if childrensDay.Equal(tombSweeping) {
+		// 兒童節と民族掃墓節が重なる場合は兒童節を前日に移す（木曜日に重なる場合は翌日の金曜日）
+		if childrensDay.Weekday() == time.Thursday {
+			childrensDay = childrensDay.AddDate(0, 0, 1)
+		} else {
+			childrensDay = childrensDay.AddDate(0, 0, -1)
+		}
+	}
+	holidays = append(holidays,
+		domain.Holiday{Date: childrensDay, Name: "兒童節", Type: domain.HolidayTypeNational, Method: domain.HolidayMethodFixed},
+		domain.Holiday{Date: tombSweeping, Name: "民族掃墓節", Type: domain.HolidayTypeNational, Method: domain.HolidayMethodAstronomical},
+	)
+
+	for _, lh := range taiwanLunarHolidays {
+		if date, ok := lunarToGregorian(year, lh.month, lh.day, taipei); ok {
+			holidays = append(holidays, domain.Holiday{Date: date, Name: lh.name, Type: domain.HolidayTypeNational, Method: domain.HolidayMethodLunar})
+		}
+	}
+
+	holidays = append(holidays, p.makeUpDays(holidays, springFestival)...)
+	sort.SliceStable(holidays, func(i, j int) bool {
+		return holidays[i].Date.Before(holidays[j].Date)
+	})
+
+	return holidays
+}
+
+// springFestival 農暦正月の連休（除夕・春節）を求める
+func (p *taiwanHolidayProvider) springFestival(year int) []domain.Holiday {
+	newYear, ok := lunarToGregorian(year, 1, 1, taipei)
+	if !ok {
+		return nil
+	}
+
+	return []domain.Holiday{
+		{Date: newYear.AddDate(0, 0, -1), Name: "農曆除夕", Type: domain.HolidayTypeNational, Method: domain.HolidayMethodLunar},
+		{Date: newYear, Name: "春節", Type: domain.HolidayTypeNational, Method: domain.HolidayMethodLunar},
+		{Date: newYear.AddDate(0, 0, 1), Name: "春節", Type: domain.HolidayTypeNational, Method: domain.HolidayMethodLunar},
+		{Date: newYear.AddDate(0, 0, 2), Name: "春節", Type: domain.HolidayTypeNational, Method: domain.HolidayMethodLunar},
+	}
+}
+
+// makeUpDays 週末に当たる祝日の補假を求める
+func (p *taiwanHolidayProvider) makeUpDays(holidays, springFestival []domain.Holiday) []domain.Holiday {
+	holidaySet := makeHolidaySet(holidays)
+	springSet := makeHolidaySet(springFestival)
+	makeUps := []domain.Holiday{}
+
+	if n := len(springFestival); n > 0 {
+		date := springFestival[n-1].Date
+		for _, h := range springFestival {
+			if !isWeekend(h.Date) {
+				continue
+			}
+			date = date.AddDate(0, 0, 1)
+			for isWeekend(date) || holidaySet[date.Format("2006-01-02")] {
+				date = date.AddDate(0, 0, 1)
+			}
+			makeUps = append(makeUps, domain.Holiday{Date: date, Name: "補假", Type: domain.HolidayTypeNational, Method: domain.HolidayMethodObserved})
+		}
+	}
+
+	for _, h := range holidays {
+		if springSet[h.Date.Format("2006-01-02")] {
+			continue
+		}
+		date, ok := usObservedDate(h.Date)
+		if !ok || holidaySet[date.Format("2006-01-02")] {
+			continue
+		}
+		makeUps = append(makeUps, domain.Holiday{Date: date, Name: "補假", Type: domain.HolidayTypeNational, Method: domain.HolidayMethodObserved})
+	}
+
+	return makeUps
+}
+
+// isWeekend 土曜日または日曜日かどうか
+func isWeekend(date time.Time) bool {
+	return date.Weekday() == time.Saturday || date.Weekday() == time.Sunday
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"sort"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// usFederalHolidayRules 米国の連邦祝日（5 U.S.C. 6103）
+var usFederalHolidayRules = []holidayRule{
+	{name: "New Year's Day", from: 1871, date: fixedDate(time.January, 1)},
+	{name: "Martin Luther King Jr. Day", from: 1986, date: nthWeekdayDate(time.January, time.Monday, 3)},
+	{name: "Washington's Birthday", from: 1879, to: 1970, date: fixedDate(time.February, 22)},
+	{name: "Washington's Birthday", from: 1971, date: nthWeekdayDate(time.February, time.Monday, 3)},
+	{name: "Memorial Day", from: 1888, to: 1970, date: fixedDate(time.May, 30)},
+	{name: "Memorial Day", from: 1971, date: nthWeekdayDate(time.May, time.Monday, -1)},
+	{name: "Juneteenth National Independence Day", from: 2021, date: fixedDate(time.June, 19)},
+	{name: "Independence Day", from: 1871, date: fixedDate(time.July, 4)},
+	{name: "Labor Day", from: 1894, date: nthWeekdayDate(time.September, time.Monday, 1)},
+	{name: "Columbus Day", from: 1937, to: 1970, date: fixedDate(time.October, 12)},
+	{name: "Columbus Day", from: 1971, date: nthWeekdayDate(time.October, time.Monday, 2)},
+	{name: "Veterans Day", from: 1938, to: 1970, date: fixedDate(time.November, 11)},
+	{name: "Veterans Day", from: 1971, to: 1977, date: nthWeekdayDate(time.October, time.Monday, 4)},
+	{name: "Veterans Day", from: 1978, date: fixedDate(time.November, 11)},
+	{name: "Thanksgiving Day", from: 1942, date: nthWeekdayDate(time.November, time.Thursday, 4)},
+	{name: "Christmas Day", from: 1871, date: fixedDate(time.December, 25)},
+}
+
+// usHolidayProvider 米国の連邦祝日
+type usHolidayProvider struct{}
+
+func (p *usHolidayProvider) Region() string {
+	return "US"
+}
+
+// Holidays 連邦祝日と、土曜日・日曜日に当たる場合の振替日（Observed）を返す
+// 土曜日の祝日は前日の金曜日、日曜日の祝日は翌日の月曜日に振り替える。
+// 翌年の元日が土曜日の場合は12月31日が振替日となる
+func (p *usHolidayProvider) Holidays(year int) []domain.Holiday {
+	holidays := applyHolidayRules(usFederalHolidayRules, year)
+
+	observed := []domain.Holiday{}
+	for _, h := range holidays {
+		if date, ok := usObservedDate(h.Date); ok && date.Year() == year {
+			observed = append(observed, domain.Holiday{Date: date, Name: h.Name + " (Observed)", Type: domain.HolidayTypeNational, Method: domain.HolidayMethodObserved})
+		}
+	}
+	if nextNewYear := time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.UTC); nextNewYear.Weekday() == time.Saturday {
+		observed = append(observed, domain.Holiday{Date: nextNewYear.AddDate(0, 0, -1), Name: "New Year's Day (Observed)", Type: domain.HolidayTypeNational, Method: domain.HolidayMethodObserved})
+	}
+
+	holidays = append(holidays, observed...)
+	sort.SliceStable(holidays, func(i, j int) bool {
+		return holidays[i].Date.Before(holidays[j].Date)
+	})
+
+	return holidays
+}
+
+// usObservedDate 週末に当たる祝日の振替日を求める
+func usObservedDate(date time.Time) (time.Time, bool) {
+	switch date.Weekday() {
+	case time.Saturday:
+		return date.AddDate(0, 0, -1), true
+	case time.Sunday:
+		return date.AddDate(0, 0, 1), true
+	}
+	return time.Time{}, false
+}
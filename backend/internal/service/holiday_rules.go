@@ -0,0 +1,145 @@
+package service
+
+import (
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// holidayDateFunc 指定年の祝日の日付と算出方法を求める関数
+type holidayDateFunc func(year int) (time.Time, string)
+
+// monthDay 月日の組
+type monthDay struct {
+	month time.Month
+	day   int
+}
+
+// holidayRule 祝日の定義
+// from/to は適用年の範囲（to が0の場合は現行法）。overrides は特定年のみ日付を移動する特例
+type holidayRule struct {
+	name      string
+	from      int
+	to        int
+	date      holidayDateFunc
+	overrides map[int]monthDay
+}
+
+// appliesTo 指定年に適用されるかを判定
+func (r holidayRule) appliesTo(year int) bool {
+	return year >= r.from && (r.to == 0 || year <= r.to)
+}
+
+// dateIn 指定年の日付と算出方法を取得（特例があれば優先）
+func (r holidayRule) dateIn(year int) (time.Time, string) {
+	if md, ok := r.overrides[year]; ok {
+		return time.Date(year, md.month, md.day, 0, 0, 0, 0, time.UTC), domain.HolidayMethodSpecial
+	}
+	return r.date(year)
+}
+
+// applyHolidayRules 祝日の定義から指定年の祝日一覧を作成
+func applyHolidayRules(rules []holidayRule, year int) []domain.Holiday {
+	holidays := []domain.Holiday{}
+
+	for _, rule := range rules {
+		if !rule.appliesTo(year) {
+			continue
+		}
+		date, method := rule.dateIn(year)
+		holidays = append(holidays, domain.Holiday{
+			Date:   date,
+			Name:   rule.name,
+			Type:   domain.HolidayTypeNational,
+			Method: method,
+		})
+	}
+
+	return holidays
+}
+
+// fixedDate 固定日の祝日
+func fixedDate(month time.Month, day int) holidayDateFunc {
+	return func(year int) (time.Time, string) {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC), domain.HolidayMethodFixed
+	}
+}
+
+// happyMonday 指定月の第N月曜日の祝日
+func happyMonday(month time.Month, n int) holidayDateFunc {
+	return func(year int) (time.Time, string) {
+		return nthWeekday(year, month, time.Monday, n), domain.HolidayMethodHappyMonday
+	}
+}
+
+// nthWeekdayDate 指定月の第N〇曜日の祝日（n が負の場合は最終週から数える）
+func nthWeekdayDate(month time.Month, weekday time.Weekday, n int) holidayDateFunc {
+	return func(year int) (time.Time, string) {
+		return nthWeekday(year, month, weekday, n), domain.HolidayMethodNthWeekday
+	}
+}
+
+// equinoxDay 太陽黄経が指定角度となる日の祝日（春分の日・秋分の日）
+func equinoxDay(longitude float64) holidayDateFunc {
+	return func(year int) (time.Time, string) {
+		return equinoxDate(year, longitude), domain.HolidayMethodAstronomical
+	}
+}
+
+// 東京オリンピック・パラリンピック特措法による2020・2021年の祝日移動
+var (
+	umiNoHiOverrides   = map[int]monthDay{2020: {time.July, 23}, 2021: {time.July, 22}}
+	sportsDayOverrides = map[int]monthDay{2020: {time.July, 24}, 2021: {time.July, 23}}
+	yamaNoHiOverrides  = map[int]monthDay{2020: {time.August, 10}, 2021: {time.August, 8}}
+)
+
+// holidayRules 国民の祝日に関する法律（1948年7月20日施行）と改正の履歴
+var holidayRules = []holidayRule{
+	{name: "元日", from: 1949, date: fixedDate(time.January, 1)},
+	{name: "成人の日", from: 1949, to: 1999, date: fixedDate(time.January, 15)},
+	{name: "成人の日", from: 2000, date: happyMonday(time.January, 2)},
+	{name: "建国記念の日", from: 1967, date: fixedDate(time.February, 11)},
+	{name: "天皇誕生日", from: 1949, to: 1988, date: fixedDate(time.April, 29)},
+	{name: "天皇誕生日", from: 1989, to: 2018, date: fixedDate(time.December, 23)},
+	{name: "天皇誕生日", from: 2020, date: fixedDate(time.February, 23)},
+	{name: "春分の日", from: 1949, date: equinoxDay(0)},
+	{name: "みどりの日", from: 1989, to: 2006, date: fixedDate(time.April, 29)},
+	{name: "昭和の日", from: 2007, date: fixedDate(time.April, 29)},
+	{name: "憲法記念日", from: 1949, date: fixedDate(time.May, 3)},
+	{name: "みどりの日", from: 2007, date: fixedDate(time.May, 4)},
+	{name: "こどもの日", from: 1949, date: fixedDate(time.May, 5)},
+	{name: "海の日", from: 1996, to: 2002, date: fixedDate(time.July, 20)},
+	{name: "海の日", from: 2003, date: happyMonday(time.July, 3), overrides: umiNoHiOverrides},
+	{name: "山の日", from: 2016, date: fixedDate(time.August, 11), overrides: yamaNoHiOverrides},
+	{name: "敬老の日", from: 1966, to: 2002, date: fixedDate(time.September, 15)},
+	{name: "敬老の日", from: 2003, date: happyMonday(time.September, 3)},
+	{name: "秋分の日", from: 1948, date: equinoxDay(180)},
+	{name: "体育の日", from: 1966, to: 1999, date: fixedDate(time.October, 10)},
+	{name: "体育の日", from: 2000, to: 2019, date: happyMonday(time.October, 2)},
+	{name: "スポーツの日", from: 2020, date: happyMonday(time.October, 2), overrides: sportsDayOverrides},
+	{name: "文化の日", from: 1948, date: fixedDate(time.November, 3)},
+	{name: "勤労感謝の日", from: 1948, date: fixedDate(time.November, 23)},
+}
+
+// specialHolidays 皇室の慶弔等に伴い特別法で定められた一度限りの休日
+var specialHolidays = []domain.Holiday{
+	{Date: time.Date(1959, 4, 10, 0, 0, 0, 0, time.UTC), Name: "皇太子明仁親王の結婚の儀", Type: domain.HolidayTypeNational, Method: domain.HolidayMethodSpecial},
+	{Date: time.Date(1989, 2, 24, 0, 0, 0, 0, time.UTC), Name: "昭和天皇の大喪の礼", Type: domain.HolidayTypeNational, Method: domain.HolidayMethodSpecial},
+	{Date: time.Date(1990, 11, 12, 0, 0, 0, 0, time.UTC), Name: "即位礼正殿の儀", Type: domain.HolidayTypeNational, Method: domain.HolidayMethodSpecial},
+	{Date: time.Date(1993, 6, 9, 0, 0, 0, 0, time.UTC), Name: "皇太子徳仁親王の結婚の儀", Type: domain.HolidayTypeNational, Method: domain.HolidayMethodSpecial},
+	{Date: time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC), Name: "天皇の即位の日", Type: domain.HolidayTypeNational, Method: domain.HolidayMethodSpecial},
+	{Date: time.Date(2019, 10, 22, 0, 0, 0, 0, time.UTC), Name: "即位礼正殿の儀", Type: domain.HolidayTypeNational, Method: domain.HolidayMethodSpecial},
+}
+
+// getNationalHolidays 指定年の国民の祝日（振替休日・国民の休日を除く）を取得
+func (s *CalendarService) getNationalHolidays(year int) []domain.Holiday {
+	holidays := applyHolidayRules(holidayRules, year)
+
+	for _, h := range specialHolidays {
+		if h.Date.Year() == year {
+			holidays = append(holidays, h)
+		}
+	}
+
+	return holidays
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"testing"
+)
+
+func TestCalendarService_GetHolidays_HistoricalRules(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+
+	tests := []struct {
+		name     string
+		year     int
+		month    int
+		day      int
+		expected string
+	}{
+		{"1988年の天皇誕生日は4/29", 1988, 4, 29, "天皇誕生日"},
+		{"1989年の4/29はみどりの日", 1989, 4, 29, "みどりの日"},
+		{"1989年の天皇誕生日は12/23", 1989, 12, 23, "天皇誕生日"},
+		{"2007年の4/29は昭和の日", 2007, 4, 29, "昭和の日"},
+		{"2007年の5/4はみどりの日", 2007, 5, 4, "みどりの日"},
+		{"1999年の成人の日は1/15", 1999, 1, 15, "成人の日"},
+		{"2000年の成人の日は第2月曜日", 2000, 1, 10, "成人の日"},
+		{"1999年の体育の日は10/10", 1999, 10, 10, "体育の日"},
+		{"2019年の体育の日は第2月曜日", 2019, 10, 14, "体育の日"},
+		{"2002年の海の日は7/20", 2002, 7, 20, "海の日"},
+		{"2002年の敬老の日は9/15", 2002, 9, 15, "敬老の日"},
+		{"2003年の敬老の日は第3月曜日", 2003, 9, 15, "敬老の日"},
+		{"2016年から山の日", 2016, 8, 11, "山の日"},
+		{"2020年の海の日（五輪特例）", 2020, 7, 23, "海の日"},
+		{"2020年のスポーツの日（五輪特例）", 2020, 7, 24, "スポーツの日"},
+		{"2020年の山の日（五輪特例）", 2020, 8, 10, "山の日"},
+		{"2021年の海の日（五輪特例）", 2021, 7, 22, "海の日"},
+		{"2021年のスポーツの日（五輪特例）", 2021, 7, 23, "スポーツの日"},
+		{"2021年の山の日（五輪特例）", 2021, 8, 8, "山の日"},
+		{"2021年の山の日（日）の振替", 2021, 8, 9, "振替休日"},
+		{"1989年 昭和天皇の大喪の礼", 1989, 2, 24, "昭和天皇の大喪の礼"},
+		{"2019年 天皇の即位の日", 2019, 5, 1, "天皇の即位の日"},
+		{"2019年 即位の日前日の国民の休日", 2019, 4, 30, "国民の休日"},
+		{"2019年 即位の日翌日の国民の休日", 2019, 5, 2, "国民の休日"},
+		{"2019年 即位礼正殿の儀", 2019, 10, 22, "即位礼正殿の儀"},
+		{"1988年 初の国民の休日", 1988, 5, 4, "国民の休日"},
+		{"1973年 初の振替休日", 1973, 4, 30, "振替休日"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			holidays := service.GetHolidays(test.year)
+			name, ok := findHoliday(holidays, test.year, test.month, test.day)
+			if !ok {
+				t.Fatalf("%d-%02d-%02d should be a holiday", test.year, test.month, test.day)
+			}
+			if name != test.expected {
+				t.Errorf("Expected '%s', got '%s'", test.expected, name)
+			}
+		})
+	}
+}
+
+func TestCalendarService_GetHolidays_NotHoliday(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+
+	tests := []struct {
+		name  string
+		year  int
+		month int
+		day   int
+	}{
+		{"2015年に山の日はない", 2015, 8, 11},
+		{"2019年に天皇誕生日はない", 2019, 2, 23},
+		{"2019年に12/23の天皇誕生日はない", 2019, 12, 23},
+		{"2020年は7月第3月曜日が海の日ではない", 2020, 7, 20},
+		{"2020年は8/11が山の日ではない", 2020, 8, 11},
+		{"2021年は10月第2月曜日がスポーツの日ではない", 2021, 10, 11},
+		{"1966年に建国記念の日はない", 1966, 2, 11},
+		{"1995年に海の日はない", 1995, 7, 20},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			holidays := service.GetHolidays(test.year)
+			if name, ok := findHoliday(holidays, test.year, test.month, test.day); ok {
+				t.Errorf("%d-%02d-%02d should not be a holiday, got '%s'", test.year, test.month, test.day, name)
+			}
+		})
+	}
+}
+
+func TestCalendarService_GetHolidays_Before1948(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+
+	if holidays := service.GetHolidays(1947); len(holidays) != 0 {
+		t.Errorf("Expected no holidays before the 1948 act, got %d", len(holidays))
+	}
+
+	// 1948年は7月20日施行のため秋分の日・文化の日・勤労感謝の日のみ
+	if holidays := service.GetHolidays(1948); len(holidays) != 3 {
+		t.Errorf("Expected 3 holidays in 1948, got %d", len(holidays))
+	}
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// weekdayNamesEnglish 曜日の英語名
+var weekdayNamesEnglish = map[time.Weekday]string{
+	time.Sunday:    "Sun",
+	time.Monday:    "Mon",
+	time.Tuesday:   "Tue",
+	time.Wednesday: "Wed",
+	time.Thursday:  "Thu",
+	time.Friday:    "Fri",
+	time.Saturday:  "Sat",
+}
+
+// rokuyoNamesRomaji 六曜のローマ字表記
+var rokuyoNamesRomaji = map[string]string{
+	"先勝": "Sensho",
+	"友引": "Tomobiki",
+	"先負": "Senbu",
+	"仏滅": "Butsumetsu",
+	"大安": "Taian",
+	"赤口": "Shakko",
+}
+
+// holidayNamesEnglish 日本・台湾の祝日名の英語名
+// 米国の祝日は英語名で定義しているため含まない
+var holidayNamesEnglish = map[string]string{
+	// 日本
+	"元日":           "New Year's Day",
+	"成人の日":         "Coming of Age Day",
+	"建国記念の日":       "National Foundation Day",
+	"天皇誕生日":        "The Emperor's Birthday",
+	"春分の日":         "Vernal Equinox Day",
+	"みどりの日":        "Greenery Day",
+	"昭和の日":         "Showa Day",
+	"憲法記念日":        "Constitution Memorial Day",
+	"こどもの日":        "Children's Day",
+	"海の日":          "Marine Day",
+	"山の日":          "Mountain Day",
+	"敬老の日":         "Respect for the Aged Day",
+	"秋分の日":         "Autumnal Equinox Day",
+	"体育の日":         "Health and Sports Day",
+	"スポーツの日":       "Sports Day",
+	"文化の日":         "Culture Day",
+	"勤労感謝の日":       "Labor Thanksgiving Day",
+	"振替休日":         "Substitute Holiday",
+	"国民の休日":        "Citizens' Holiday",
+	"休日":           "Holiday",
+	"休日（祝日扱い）":     "Holiday (treated as a national holiday)",
+	"皇太子明仁親王の結婚の儀": "Wedding Ceremony of Crown Prince Akihito",
+	"昭和天皇の大喪の礼":    "Funeral Ceremony of Emperor Showa",
+	"即位礼正殿の儀":      "Ceremony of the Enthronement",
+	"皇太子徳仁親王の結婚の儀": "Wedding Ceremony of Crown Prince Naruhito",
+	"天皇の即位の日":      "Enthronement Day",
+	// 台湾
+	"開國紀念日": "Founding Day of the Republic of China",
+	"和平紀念日": "Peace Memorial Day",
+	"勞動節":   "Labor Day",
+	"教師節":   "Teachers' Day",
+	"國慶日":   "National Day",
+	"臺灣光復節": "Taiwan Retrocession Day",
+	"行憲紀念日": "Constitution Day",
+	"端午節":   "Dragon Boat Festival",
+	"中秋節":   "Mid-Autumn Festival",
+	"兒童節":   "Children's Day",
+	"民族掃墓節": "Tomb Sweeping Day",
+	"農曆除夕":  "Lunar New Year's Eve",
+	"春節":    "Lunar New Year",
+	"補假":    "Observed Holiday",
+}
+
+// supportedLanguage 表示言語に対応しているかどうか（空の場合は日本語）
+func supportedLanguage(lang string) bool {
+	return lang == "" || lang == domain.LanguageJapanese || lang == domain.LanguageEnglish
+}
+
+// localizeWeekday 曜日名を指定言語で取得
+func localizeWeekday(weekday time.Weekday, lang string) string {
+	if lang == domain.LanguageEnglish {
+		return weekdayNamesEnglish[weekday]
+	}
+	return japaneseWeekdayName(weekday)
+}
+
+// localizeRokuyo 六曜を指定言語で取得（英語の場合はローマ字）
+func localizeRokuyo(rokuyo, lang string) string {
+	if lang == domain.LanguageEnglish {
+		if romaji, ok := rokuyoNamesRomaji[rokuyo]; ok {
+			return romaji
+		}
+	}
+	return rokuyo
+}
+
+// localizeHolidayName 祝日名を指定言語で取得
+// 翻訳のない名称（組織独自の休日など）はそのまま返す
+func localizeHolidayName(name, lang string) string {
+	if lang == domain.LanguageEnglish {
+		if english, ok := holidayNamesEnglish[name]; ok {
+			return english
+		}
+	}
+	return name
+}
+
+// LocalizeHolidays 祝日名を指定言語に置き換えた祝日一覧
+func (s *CalendarService) LocalizeHolidays(holidays []domain.Holiday, lang string) []domain.Holiday {
+	return localizeHolidays(holidays, lang)
+}
+
+// localizeHolidays 祝日名を指定言語に置き換えた祝日一覧（元の一覧は変更しない）
+func localizeHolidays(holidays []domain.Holiday, lang string) []domain.Holiday {
+	localized := make([]domain.Holiday, 0, len(holidays))
+	for _, h := range holidays {
+		h.Name = localizeHolidayName(h.Name, lang)
+		localized = append(localized, h)
+	}
+	return localized
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+func TestCalendarService_GetCalendar_English(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{
+		GetByDateRangeFunc: func(start, end time.Time) ([]domain.CustomHoliday, error) {
+			return []domain.CustomHoliday{{
+				ID:        1,
+				Name:      "創立記念日",
+				StartDate: time.Date(2025, 11, 4, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2025, 11, 4, 0, 0, 0, 0, time.UTC),
+			}}, nil
+		},
+	}, &MockEventRepository{})
+
+	calendar, err := service.GetCalendar(2025, 11, domain.CalendarOptions{Language: domain.LanguageEnglish})
+	if err != nil {
+		t.Fatalf("GetCalendar should not return error: %v", err)
+	}
+
+	// 2025年11月3日（月）文化の日
+	culture := calendar.Days[2]
+	if culture.Weekday != "Mon" || culture.Holiday != "Culture Day" || culture.Holidays[0].Name != "Culture Day" {
+		t.Errorf("Unexpected English day: weekday %s, holiday %s", culture.Weekday, culture.Holiday)
+	}
+
+	// 勤労感謝の日（日曜日）と振替休日
+	if calendar.Days[22].Holiday != "Labor Thanksgiving Day" || calendar.Days[23].Holiday != "Substitute Holiday" {
+		t.Errorf("Expected Labor Thanksgiving Day and Substitute Holiday, got %s and %s", calendar.Days[22].Holiday, calendar.Days[23].Holiday)
+	}
+
+	// 独自休日の名称は翻訳しない
+	if calendar.Days[3].Holiday != "創立記念日" {
+		t.Errorf("Expected custom holiday name unchanged, got %s", calendar.Days[3].Holiday)
+	}
+
+	romaji := map[string]bool{"Sensho": true, "Tomobiki": true, "Senbu": true, "Butsumetsu": true, "Taian": true, "Shakko": true}
+	for _, day := range calendar.Days {
+		if !romaji[day.Rokuyo] {
+			t.Errorf("%s: Expected rokuyo in romaji, got %s", day.Date.Format("2006-01-02"), day.Rokuyo)
+		}
+	}
+
+	if _, err := service.GetCalendar(2025, 11, domain.CalendarOptions{Language: "fr"}); err != domain.ErrInvalidInput {
+		t.Errorf("Expected ErrInvalidInput for unsupported language, got %v", err)
+	}
+}
+
+func TestHolidayNamesEnglish_Coverage(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+
+	// 日本と台湾の祝日名はすべて英語名を持つ
+	for year := 1948; year <= 2030; year++ {
+		holidays, err := service.GetHolidaysForRegions(year, []string{"JP", "TW"})
+		if err != nil {
+			t.Fatalf("GetHolidaysForRegions should not return error: %v", err)
+		}
+		for _, h := range service.LocalizeHolidays(holidays, domain.LanguageEnglish) {
+			if _, ok := holidayNamesEnglish[h.Name]; ok {
+				t.Errorf("%s: %s has no English name", h.Date.Format("2006-01-02"), h.Name)
+			}
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"math"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+const (
+	// synodicMonth 朔望月の平均長（日）
+	synodicMonth = 29.530588861
+	// newMoonEpochJDE 2000年1月6日の朔（k=0）の力学時ユリウス日
+	newMoonEpochJDE = 2451550.09766
+	// maxLunationAdjust 朔の番号の概算値を補正する最大回数
+	maxLunationAdjust = 12
+)
+
+// newMoon k番目の朔の時刻を計算（k=0 は2000年1月6日の朔）
+// Meeus『Astronomical Algorithms』第49章の式で、誤差は1分未満
+func newMoon(k int) time.Time {
+	kf := float64(k)
+	t := kf / 1236.85
+
+	jde := newMoonEpochJDE + synodicMonth*kf + 0.00015437*t*t - 0.000000150*t*t*t + 0.00000000073*t*t*t*t
+
+	e := 1 - 0.002516*t - 0.0000074*t*t
+	m := 2.5534 + 29.10535670*kf - 0.0000014*t*t - 0.00000011*t*t*t
+	mp := 201.5643 + 385.81693528*kf + 0.0107582*t*t + 0.00001238*t*t*t - 0.000000058*t*t*t*t
+	f := 160.7108 + 390.67050284*kf - 0.0016118*t*t - 0.00000227*t*t*t + 0.000000011*t*t*t*t
+	omega := 124.7746 - 1.56375588*kf + 0.0020672*t*t + 0.00000215*t*t*t
+
+	// 月・太陽の近点角と昇交点引数による補正
+	correction := -0.40720*sinDeg(mp) +
+		0.17241*e*sinDeg(m) +
+		0.01608*sinDeg(2*mp) +
+		0.01039*sinDeg(2*f) +
+		0.00739*e*sinDeg(mp-m) -
+		0.00514*e*sinDeg(mp+m) +
+		0.00208*e*e*sinDeg(2*m) -
+		0.00111*sinDeg(mp-2*f) -
+		0.00057*sinDeg(mp+2*f) +
+		0.00056*e*sinDeg(2*mp+m) -
+		0.00042*sinDeg(3*mp) +
+		0.00042*e*sinDeg(m+2*f) +
+		0.00038*e*sinDeg(m-2*f) -
+		0.00024*e*sinDeg(2*mp-m) -
+		0.00017*sinDeg(omega) -
+		0.00007*sinDeg(mp+2*m) +
+		0.00004*sinDeg(2*mp-2*f) +
+		0.00004*sinDeg(3*m) +
+		0.00003*sinDeg(mp+m-2*f) +
+		0.00003*sinDeg(2*mp+2*f) -
+		0.00003*sinDeg(mp+m+2*f) +
+		0.00003*sinDeg(mp-m+2*f) -
+		0.00002*sinDeg(mp-m-2*f) -
+		0.00002*sinDeg(3*mp+m) +
+		0.00002*sinDeg(4*mp)
+
+	// 惑星による追加補正
+	planetary := []struct{ amplitude, angle float64 }{
+		{0.000325, 299.77 + 0.107408*kf - 0.009173*t*t},
+		{0.000165, 251.88 + 0.016321*kf},
+		{0.000164, 251.83 + 26.651886*kf},
+		{0.000126, 349.42 + 36.412478*kf},
+		{0.000110, 84.66 + 18.206239*kf},
+		{0.000062, 141.74 + 53.303771*kf},
+		{0.000060, 207.14 + 2.453732*kf},
+		{0.000056, 154.84 + 7.306860*kf},
+		{0.000047, 34.52 + 27.261239*kf},
+		{0.000042, 207.19 + 0.121824*kf},
+		{0.000040, 291.34 + 1.844379*kf},
+		{0.000037, 161.72 + 24.198154*kf},
+		{0.000035, 239.56 + 25.513099*kf},
+		{0.000023, 331.55 + 3.592518*kf},
+	}
+	for _, p := range planetary {
+		correction += p.amplitude * sinDeg(p.angle)
+	}
+
+	jde += correction
+
+	// 力学時から世界時へ
+	year := 2000 + kf/12.3685
+	return timeFromJulianDay(jde - deltaT(year)/86400)
+}
+
+// newMoonDay k番目の朔を含む日付（指定タイムゾーン）
+func newMoonDay(k int, loc *time.Location) time.Time {
+	return dateIn(newMoon(k), loc)
+}
+
+// lunationOnOrBefore 指定日以前で最も近い朔の番号を取得
+func lunationOnOrBefore(date time.Time, loc *time.Location) int {
+	k := int(math.Floor((julianDay(midnightIn(date, loc)) - newMoonEpochJDE) / synodicMonth))
+	// 概算値からのずれは高々数朔望月のため、計算できない日付でも無限に回らないよう回数を制限する
+	for i := 0; i < maxLunationAdjust && newMoonDay(k, loc).After(date); i++ {
+		k--
+	}
+	for i := 0; i < maxLunationAdjust && !newMoonDay(k+1, loc).After(date); i++ {
+		k++
+	}
+	return k
+}
+
+// winterSolsticeDay 指定年の冬至の日付（指定タイムゾーン）
+func winterSolsticeDay(year int, loc *time.Location) time.Time {
+	return dateIn(solarTermTime(270, time.Date(year, time.December, 21, 0, 0, 0, 0, time.UTC)), loc)
+}
+
+// hasPrincipalTerm 期間 [start, end) の日付に中気（太陽黄経が30度の倍数）を含むかを判定
+func hasPrincipalTerm(start, end time.Time, loc *time.Location) bool {
+	from := math.Floor(sunLongitude(julianDay(midnightIn(start, loc))) / 30)
+	to := math.Floor(sunLongitude(julianDay(midnightIn(end, loc))) / 30)
+	return from != to
+}
+
+// toLunarDate 日付を旧暦に変換
+func toLunarDate(date time.Time) domain.LunarDate {
+	return toLunarDateIn(date, jst)
+}
+
+// toLunarDateIn 指定タイムゾーンを基準に日付を太陰太陽暦に変換
+// 天保暦（中国の時憲暦も同様）の方式に従い、朔日を月の始まりとし、冬至を含む月を11月とする。
+// 冬至から次の冬至までに13か月ある年は、中気を含まない最初の月を閏月とする
+func toLunarDateIn(date time.Time, loc *time.Location) domain.LunarDate {
+	year := date.Year()
+	first := lunationOnOrBefore(winterSolsticeDay(year, loc), loc)
+	if date.Before(newMoonDay(first, loc)) {
+		year--
+		first = lunationOnOrBefore(winterSolsticeDay(year, loc), loc)
+	}
+	last := lunationOnOrBefore(winterSolsticeDay(year+1, loc), loc)
+
+	hasLeap := last-first == 13
+	leapAssigned := false
+	month := 10
+	lunarYear := year
+
+	for k := first; k < last; k++ {
+		start := newMoonDay(k, loc)
+		end := newMoonDay(k+1, loc)
+
+		leap := false
+		if hasLeap && !leapAssigned && !hasPrincipalTerm(start, end, loc) {
+			leap = true
+			leapAssigned = true
+		} else {
+			month = month%12 + 1
+			if month == 1 {
+				lunarYear = year + 1
+			}
+		}
+
+		if date.Before(end) {
+			return domain.LunarDate{
+				Year:        lunarYear,
+				Month:       month,
+				Day:         int(date.Sub(start).Hours()/24) + 1,
+				IsLeapMonth: leap,
+			}
+		}
+	}
+
+	// 冬至の月の範囲内で必ず見つかるため通常は到達しない
+	return domain.LunarDate{Year: year + 1, Month: 11, Day: int(date.Sub(newMoonDay(last, loc)).Hours()/24) + 1}
+}
+
+// lunarToGregorian 太陰太陽暦の年月日（閏月でない月）を西暦の日付に変換
+func lunarToGregorian(year, month, day int, loc *time.Location) (time.Time, bool) {
+	k := lunationOnOrBefore(time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC), loc)
+	for i := 0; i < 15; i++ {
+		start := newMoonDay(k+i, loc)
+		lunar := toLunarDateIn(start, loc)
+		if lunar.Year == year && lunar.Month == month && !lunar.IsLeapMonth {
+			return start.AddDate(0, 0, day-1), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// moonPhaseNames 月齢を8等分した月相の名称
+var moonPhaseNames = []string{"新月", "三日月", "上弦の月", "十三夜月", "満月", "居待月", "下弦の月", "有明月"}
+
+// calculateMoonAge 指定日の日本時間正午における月齢（直前の朔からの経過日数、小数第1位まで）
+func calculateMoonAge(date time.Time) float64 {
+	noon := jstMidnight(date).Add(12 * time.Hour)
+
+	k := lunationOnOrBefore(date, jst)
+	last := newMoon(k)
+	if last.After(noon) {
+		last = newMoon(k - 1)
+	}
+
+	age := noon.Sub(last).Hours() / 24
+	return math.Round(age*10) / 10
+}
+
+// moonPhaseName 月齢から月相の名称を取得
+func moonPhaseName(age float64) string {
+	index := int(math.Floor(age/synodicMonth*8+0.5)) % len(moonPhaseNames)
+	return moonPhaseNames[index]
+}
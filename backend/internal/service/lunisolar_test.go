@@ -0,0 +1,106 @@
+package service
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+func TestNewMoon(t *testing.T) {
+	// 国立天文台による朔の時刻（日本時間）
+	tests := []struct {
+		k        int
+		expected time.Time
+	}{
+		{299, time.Date(2024, 3, 10, 18, 0, 0, 0, jst)},
+		{310, time.Date(2025, 1, 29, 21, 36, 0, 0, jst)},
+		{311, time.Date(2025, 2, 28, 9, 45, 0, 0, jst)},
+	}
+
+	for _, test := range tests {
+		got := newMoon(test.k)
+		if diff := math.Abs(got.Sub(test.expected).Minutes()); diff > 2 {
+			t.Errorf("k=%d: expected %v, got %v", test.k, test.expected, got.In(jst))
+		}
+	}
+}
+
+func TestToLunarDate(t *testing.T) {
+	tests := []struct {
+		name     string
+		date     time.Time
+		expected domain.LunarDate
+	}{
+		{"2025年の旧正月", time.Date(2025, 1, 29, 0, 0, 0, 0, time.UTC), domain.LunarDate{Year: 2025, Month: 1, Day: 1}},
+		{"2025年元日は旧暦12月2日", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), domain.LunarDate{Year: 2024, Month: 12, Day: 2}},
+		{"2024年の旧正月", time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC), domain.LunarDate{Year: 2024, Month: 1, Day: 1}},
+		{"2026年の旧正月", time.Date(2026, 2, 17, 0, 0, 0, 0, time.UTC), domain.LunarDate{Year: 2026, Month: 1, Day: 1}},
+		{"2025年 閏6月の前日", time.Date(2025, 7, 24, 0, 0, 0, 0, time.UTC), domain.LunarDate{Year: 2025, Month: 6, Day: 30}},
+		{"2025年 閏6月1日", time.Date(2025, 7, 25, 0, 0, 0, 0, time.UTC), domain.LunarDate{Year: 2025, Month: 6, Day: 1, IsLeapMonth: true}},
+		{"2023年 閏2月1日", time.Date(2023, 3, 22, 0, 0, 0, 0, time.UTC), domain.LunarDate{Year: 2023, Month: 2, Day: 1, IsLeapMonth: true}},
+		{"2020年 閏4月1日", time.Date(2020, 5, 23, 0, 0, 0, 0, time.UTC), domain.LunarDate{Year: 2020, Month: 4, Day: 1, IsLeapMonth: true}},
+		{"2014年 閏9月1日", time.Date(2014, 10, 24, 0, 0, 0, 0, time.UTC), domain.LunarDate{Year: 2014, Month: 9, Day: 1, IsLeapMonth: true}},
+		{"2033年問題 閏11月1日", time.Date(2033, 12, 22, 0, 0, 0, 0, time.UTC), domain.LunarDate{Year: 2033, Month: 11, Day: 1, IsLeapMonth: true}},
+		{"2025年12月1日", time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC), domain.LunarDate{Year: 2025, Month: 10, Day: 12}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := toLunarDate(test.date)
+			if got != test.expected {
+				t.Errorf("Expected %+v, got %+v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestCalculateMoonAge(t *testing.T) {
+	tests := []struct {
+		name     string
+		date     time.Time
+		minAge   float64
+		maxAge   float64
+		expected string
+	}{
+		// 前の朔は2024-12-31 07:27
+		{"朔の前日", time.Date(2025, 1, 28, 0, 0, 0, 0, time.UTC), 28.0, 28.4, "新月"},
+		// 朔が正午より後のため、当日の正午はまだ前の朔からの月齢
+		{"朔の当日（朔は夜）", time.Date(2025, 1, 29, 0, 0, 0, 0, time.UTC), 29.0, 29.4, "新月"},
+		{"朔の翌日", time.Date(2025, 1, 30, 0, 0, 0, 0, time.UTC), 0.5, 0.8, "新月"},
+		// 2025-02-12 22:53 が望
+		{"満月", time.Date(2025, 2, 12, 0, 0, 0, 0, time.UTC), 13.5, 14.6, "満月"},
+		{"上弦", time.Date(2025, 2, 5, 0, 0, 0, 0, time.UTC), 6.5, 7.6, "上弦の月"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			age := calculateMoonAge(test.date)
+			if age < test.minAge || age > test.maxAge {
+				t.Errorf("Expected moon age between %.1f and %.1f, got %.1f", test.minAge, test.maxAge, age)
+			}
+			if phase := moonPhaseName(age); phase != test.expected {
+				t.Errorf("Expected phase %s, got %s", test.expected, phase)
+			}
+		})
+	}
+}
+
+func TestLunationOnOrBefore_OutOfRange(t *testing.T) {
+	// 対応範囲外の日付でも概算値から補正の上限以内で、その日以前の直近の朔を返すこと
+	for _, date := range []time.Time{
+		time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		k := lunationOnOrBefore(date, jst)
+		estimate := int(math.Floor((julianDay(midnightIn(date, jst)) - newMoonEpochJDE) / synodicMonth))
+		if k < estimate-maxLunationAdjust || k > estimate+maxLunationAdjust {
+			t.Errorf("%s: Expected lunation within %d of %d, got %d", date.Format("2006-01-02"), maxLunationAdjust, estimate, k)
+		}
+		if newMoonDay(k, jst).After(date) || !newMoonDay(k+1, jst).After(date) {
+			t.Errorf("%s: Expected new moon %s to be the last one on or before the date (next %s)", date.Format("2006-01-02"),
+				newMoonDay(k, jst).Format("2006-01-02"), newMoonDay(k+1, jst).Format("2006-01-02"))
+		}
+	}
+}
@@ -0,0 +1,695 @@
+package service
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// rruleWeekdays RRULE の曜日の表記
+var rruleWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+// rruleByDayPattern BYDAY の要素（例: MO、2TU、-1FR）
+var rruleByDayPattern = regexp.MustCompile(`^([+-]?[0-9]{1,2})?([A-Z]{2})$`)
+
+// weekdayNum BYDAY の要素
+// N が 0 の場合は該当する全ての曜日、正の場合は期間の先頭から、負の場合は末尾から数えた N 番目
+type weekdayNum struct {
+	N       int
+	Weekday time.Weekday
+}
+
+// recurrenceRule 解析した RRULE（RFC 5545）
+// FREQ は DAILY・WEEKLY・MONTHLY・YEARLY に対応し、BYHOUR などの時刻の指定は扱わない
+type recurrenceRule struct {
+	Freq       string
+	Interval   int
+	Count      int
+	Until      time.Time
+	ByDay      []weekdayNum
+	ByMonthDay []int
+	ByMonth    []int
+	BySetPos   []int
+	WeekStart  time.Weekday
+
+	// BusinessDay 月の第N営業日（営業日に基づく繰り返しの場合のみ、0 の場合は使わない）
+	BusinessDay int
+	// calendar 営業日の判定に使う休日
+	calendar *businessCalendar
+	// err 営業日の判定中に発生したエラー
+	err error
+}
+
+// parseRRule RRULE を解析する（先頭の "RRULE:" は省略できる）
+// 日付のみの UNTIL はその日の終わりまで、タイムゾーンのない UNTIL は loc の日時として扱う
+func parseRRule(value string, loc *time.Location) (*recurrenceRule, error) {
+	value = strings.TrimSpace(value)
+	if len(value) >= 6 && strings.EqualFold(value[:6], "RRULE:") {
+		value = value[6:]
+	}
+
+	rule := &recurrenceRule{Interval: 1, WeekStart: time.Monday}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(value, ";") {
+		key, val, ok := strings.Cut(part, "=")
+		key = strings.ToUpper(strings.TrimSpace(key))
+		val = strings.ToUpper(strings.TrimSpace(val))
+		if !ok || val == "" || seen[key] {
+			return nil, domain.ErrInvalidInput
+		}
+		seen[key] = true
+
+		var err error
+		switch key {
+		case "FREQ":
+			rule.Freq = val
+		case "INTERVAL":
+			rule.Interval, err = parseRRuleInt(val, 1, 1000)
+		case "COUNT":
+			rule.Count, err = parseRRuleInt(val, 1, 10000)
+		case "UNTIL":
+			rule.Until, err = parseRRuleUntil(val, loc)
+		case "BYDAY":
+			rule.ByDay, err = parseRRuleByDay(val)
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseRRuleInts(val, 1, 31)
+		case "BYMONTH":
+			rule.ByMonth, err = parseRRuleInts(val, 1, 12)
+			for _, month := range rule.ByMonth {
+				if month < 0 {
+					err = domain.ErrInvalidInput
+				}
+			}
+		case "BYSETPOS":
+			rule.BySetPos, err = parseRRuleInts(val, 1, 366)
+		case "WKST":
+			wd, ok := rruleWeekdays[val]
+			if !ok {
+				err = domain.ErrInvalidInput
+			}
+			rule.WeekStart = wd
+		default:
+			// 対応していない指定は無視せずエラーにする
+			err = domain.ErrInvalidInput
+		}
+		if err != nil {
+			return nil, domain.ErrInvalidInput
+		}
+	}
+
+	switch rule.Freq {
+	case "DAILY", "WEEKLY", "MONTHLY", "YEARLY":
+	default:
+		return nil, domain.ErrInvalidInput
+	}
+	if rule.Count > 0 && !rule.Until.IsZero() {
+		return nil, domain.ErrInvalidInput
+	}
+	if rule.Freq == "WEEKLY" && len(rule.ByMonthDay) > 0 {
+		return nil, domain.ErrInvalidInput
+	}
+	for _, wn := range rule.ByDay {
+		// 序数付きの曜日は月・年単位の繰り返しでのみ使える
+		if wn.N != 0 && rule.Freq != "MONTHLY" && rule.Freq != "YEARLY" {
+			return nil, domain.ErrInvalidInput
+		}
+	}
+	if len(rule.BySetPos) > 0 && len(rule.ByDay) == 0 && len(rule.ByMonthDay) == 0 && len(rule.ByMonth) == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return rule, nil
+}
+
+// parseRRuleInt min〜max の整数を解析
+func parseRRuleInt(value string, min, max int) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < min || n > max {
+		return 0, domain.ErrInvalidInput
+	}
+	return n, nil
+}
+
+// parseRRuleInts カンマ区切りの ±1〜max の整数を解析
+func parseRRuleInts(value string, min, max int) ([]int, error) {
+	values := []int{}
+	for _, item := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(item)
+		if err != nil || n == 0 || n > max || n < -max || (n > 0 && n < min) {
+			return nil, domain.ErrInvalidInput
+		}
+		values = append(values, n)
+	}
+	return values, nil
+}
+
+// parseRRuleByDay BYDAY を解析
+func parseRRuleByDay(value string) ([]weekdayNum, error) {
+	days := []weekdayNum{}
+	for _, item := range strings.Split(value, ",") {
+		match := rruleByDayPattern.FindStringSubmatch(item)
+		if match == nil {
+			return nil, domain.ErrInvalidInput
+		}
+		wd, ok := rruleWeekdays[match[2]]
+		if !ok {
+			return nil, domain.ErrInvalidInput
+		}
+		n := 0
+		if match[1] != "" {
+			n, _ = strconv.Atoi(match[1])
+			if n == 0 || n > 53 || n < -53 {
+				return nil, domain.ErrInvalidInput
+			}
+		}
+		days = append(days, weekdayNum{N: n, Weekday: wd})
+	}
+	return days, nil
+}
+
+// parseRRuleUntil UNTIL を解析（20251231、20251231T090000、20251231T000000Z）
+func parseRRuleUntil(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("20060102T150405", value, loc); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation("20060102", value, loc)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.AddDate(0, 0, 1).Add(-time.Nanosecond), nil
+}
+
+// formatRRuleUntil UNTIL の表記（UTC）
+func formatRRuleUntil(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// replaceRRuleParts RRULE の指定を置き換える（値が空の場合は取り除き、ない場合は末尾に追加する）
+func replaceRRuleParts(value string, parts map[string]string) string {
+	value = strings.TrimSpace(value)
+	if len(value) >= 6 && strings.EqualFold(value[:6], "RRULE:") {
+		value = value[6:]
+	}
+
+	result := []string{}
+	done := make(map[string]bool)
+	for _, part := range strings.Split(value, ";") {
+		key, _, _ := strings.Cut(part, "=")
+		key = strings.ToUpper(strings.TrimSpace(key))
+		if replacement, ok := parts[key]; ok {
+			done[key] = true
+			if replacement != "" {
+				result = append(result, key+"="+replacement)
+			}
+			continue
+		}
+		result = append(result, part)
+	}
+
+	keys := make([]string, 0, len(parts))
+	for key := range parts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !done[key] && parts[key] != "" {
+			result = append(result, key+"="+parts[key])
+		}
+	}
+	return strings.Join(result, ";")
+}
+
+// occurrences 規則に従う回の開始日時を古い順に fn に渡す
+// 開始日時（dtstart）は規則に合わなくても最初の回として数え（第N営業日の繰り返しを除く）、
+// 期間の始まりが horizon を過ぎるか fn が false を返すと止まる。営業日の判定に失敗した場合は r.err に設定して止まる
+func (r *recurrenceRule) occurrences(dtstart, horizon time.Time, fn func(time.Time) bool) {
+	loc := dtstart.Location()
+	base := time.Date(dtstart.Year(), dtstart.Month(), dtstart.Day(), 0, 0, 0, 0, time.UTC)
+	last := horizon.In(loc)
+	last = time.Date(last.Year(), last.Month(), last.Day(), 0, 0, 0, 0, time.UTC)
+	if !r.Until.IsZero() {
+		until := r.Until.In(loc)
+		if until = time.Date(until.Year(), until.Month(), until.Day(), 0, 0, 0, 0, time.UTC); until.Before(last) {
+			last = until
+		}
+	}
+
+	if !r.Until.IsZero() && dtstart.After(r.Until) {
+		return
+	}
+	count := 0
+	if r.BusinessDay == 0 {
+		count++
+		if !fn(dtstart) {
+			return
+		}
+	}
+
+	for i := 0; ; i++ {
+		periodStart, dates := r.periodDates(base, i)
+		if r.err != nil || periodStart.After(last) {
+			return
+		}
+		for _, date := range dates {
+			t := time.Date(date.Year(), date.Month(), date.Day(), dtstart.Hour(), dtstart.Minute(), dtstart.Second(), dtstart.Nanosecond(), loc)
+			if t.Before(dtstart) || (r.BusinessDay == 0 && t.Equal(dtstart)) {
+				continue
+			}
+			if !r.Until.IsZero() && t.After(r.Until) {
+				return
+			}
+			if r.Count > 0 && count >= r.Count {
+				return
+			}
+			count++
+			if !fn(t) {
+				return
+			}
+		}
+	}
+}
+
+// periodDates base を含む期間から数えて i*INTERVAL 番目の期間（日・週・月・年）の始まりと、規則に合う日付
+func (r *recurrenceRule) periodDates(base time.Time, i int) (time.Time, []time.Time) {
+	var start time.Time
+	dates := []time.Time{}
+
+	switch r.Freq {
+	case "DAILY":
+		start = base.AddDate(0, 0, i*r.Interval)
+		if r.matchesDay(start, false) {
+			dates = append(dates, start)
+		}
+	case "WEEKLY":
+		offset := (int(base.Weekday()) - int(r.WeekStart) + 7) % 7
+		start = base.AddDate(0, 0, -offset+7*i*r.Interval)
+		for d := 0; d < 7; d++ {
+			date := start.AddDate(0, 0, d)
+			if len(r.ByDay) == 0 && date.Weekday() != base.Weekday() {
+				continue
+			}
+			if r.matchesDay(date, true) {
+				dates = append(dates, date)
+			}
+		}
+	case "MONTHLY":
+		start = time.Date(base.Year(), base.Month()+time.Month(i*r.Interval), 1, 0, 0, 0, 0, time.UTC)
+		if len(r.ByMonth) == 0 || containsInt(r.ByMonth, int(start.Month())) {
+			dates = r.monthDates(start, base.Day())
+		}
+	case "YEARLY":
+		start = time.Date(base.Year()+i*r.Interval, 1, 1, 0, 0, 0, 0, time.UTC)
+		switch {
+		case len(r.ByMonth) > 0:
+			for _, month := range r.ByMonth {
+				dates = append(dates, r.monthDates(time.Date(start.Year(), time.Month(month), 1, 0, 0, 0, 0, time.UTC), base.Day())...)
+			}
+		case len(r.ByDay) > 0 && len(r.ByMonthDay) == 0:
+			// 月の指定がない場合、序数は年の中で数える
+			dates = weekdaysIn(start, start.AddDate(1, 0, -1), r.ByDay)
+		case len(r.ByMonthDay) > 0:
+			for month := 1; month <= 12; month++ {
+				dates = append(dates, r.monthDates(time.Date(start.Year(), time.Month(month), 1, 0, 0, 0, 0, time.UTC), base.Day())...)
+			}
+		case r.BusinessDay != 0:
+			dates = r.monthDates(time.Date(start.Year(), base.Month(), 1, 0, 0, 0, 0, time.UTC), base.Day())
+		default:
+			date := time.Date(start.Year(), base.Month(), base.Day(), 0, 0, 0, 0, time.UTC)
+			if date.Month() == base.Month() {
+				dates = append(dates, date)
+			}
+		}
+	}
+
+	sortDates(dates)
+	return start, applySetPos(uniqueDates(dates), r.BySetPos)
+}
+
+// monthDates 月内で規則に合う日付（BYMONTHDAY・BYDAY の指定がない場合は開始日と同じ日）
+func (r *recurrenceRule) monthDates(first time.Time, day int) []time.Time {
+	last := first.AddDate(0, 1, -1)
+	if r.BusinessDay != 0 {
+		return r.nthBusinessDay(first, last)
+	}
+	if len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+		// 31日始まりの毎月の繰り返しは31日のない月を飛ばす
+		if day > last.Day() {
+			return []time.Time{}
+		}
+		return []time.Time{first.AddDate(0, 0, day-1)}
+	}
+
+	var candidates []time.Time
+	if len(r.ByDay) > 0 {
+		candidates = weekdaysIn(first, last, r.ByDay)
+	} else {
+		for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+			candidates = append(candidates, d)
+		}
+	}
+
+	dates := []time.Time{}
+	for _, date := range candidates {
+		if len(r.ByMonthDay) == 0 || matchesMonthDay(date, r.ByMonthDay) {
+			dates = append(dates, date)
+		}
+	}
+	return dates
+}
+
+// matchesDay 日・週単位の繰り返しで、日付が BYMONTH・BYMONTHDAY・BYDAY に合うか
+func (r *recurrenceRule) matchesDay(date time.Time, weekly bool) bool {
+	if len(r.ByMonth) > 0 && !containsInt(r.ByMonth, int(date.Month())) {
+		return false
+	}
+	if !weekly && len(r.ByMonthDay) > 0 && !matchesMonthDay(date, r.ByMonthDay) {
+		return false
+	}
+	if len(r.ByDay) > 0 {
+		for _, wn := range r.ByDay {
+			if wn.Weekday == date.Weekday() {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// matchesMonthDay 日付が BYMONTHDAY のいずれかに当たるか（負の値は月末から数える）
+func matchesMonthDay(date time.Time, days []int) bool {
+	lastDay := date.AddDate(0, 1, -date.Day()).Day()
+	for _, d := range days {
+		if d == date.Day() || (d < 0 && lastDay+d+1 == date.Day()) {
+			return true
+		}
+	}
+	return false
+}
+
+// weekdaysIn first〜last の中で BYDAY に当たる日付
+func weekdaysIn(first, last time.Time, byDay []weekdayNum) []time.Time {
+	dates := []time.Time{}
+	for _, wn := range byDay {
+		matched := []time.Time{}
+		offset := (int(wn.Weekday) - int(first.Weekday()) + 7) % 7
+		for d := first.AddDate(0, 0, offset); !d.After(last); d = d.AddDate(0, 0, 7) {
+			matched = append(matched, d)
+		}
+		switch {
+		case wn.N == 0:
+			dates = append(dates, matched...)
+		case wn.N > 0 && wn.N <= len(matched):
+			dates = append(dates, matched[wn.N-1])
+		case wn.N < 0 && -wn.N <= len(matched):
+			dates = append(dates, matched[len(matched)+wn.N])
+		}
+	}
+	sortDates(dates)
+	return dates
+}
+
+// applySetPos BYSETPOS で期間内の何番目の日付を使うかを選ぶ
+func applySetPos(dates []time.Time, positions []int) []time.Time {
+	if len(positions) == 0 {
+		return dates
+	}
+	selected := []time.Time{}
+	for _, pos := range positions {
+		switch {
+		case pos > 0 && pos <= len(dates):
+			selected = append(selected, dates[pos-1])
+		case pos < 0 && -pos <= len(dates):
+			selected = append(selected, dates[len(dates)+pos])
+		}
+	}
+	sortDates(selected)
+	return uniqueDates(selected)
+}
+
+func sortDates(dates []time.Time) {
+	sort.Slice(dates, func(i, j int) bool {
+		return dates[i].Before(dates[j])
+	})
+}
+
+// uniqueDates 並べ替えた日付から重複を取り除く
+func uniqueDates(dates []time.Time) []time.Time {
+	unique := []time.Time{}
+	for _, date := range dates {
+		if len(unique) == 0 || !unique[len(unique)-1].Equal(date) {
+			unique = append(unique, date)
+		}
+	}
+	return unique
+}
+
+func containsInt(values []int, n int) bool {
+	for _, v := range values {
+		if v == n {
+			return true
+		}
+	}
+	return false
+}
+
+// isRecurringEvent 繰り返しイベント（シリーズ）かどうか
+func isRecurringEvent(event domain.Event) bool {
+	return event.RRule != "" || len(event.RDates) > 0
+}
+
+// validateRecurrence 繰り返しの規則を検証
+// 営業日に基づく繰り返しは RRule と合わせて指定し、祝日の地域も確かめる
+func validateRecurrence(event *domain.Event, calendar HolidayCalendarInterface) error {
+	if event.RRule == "" {
+		if event.BusinessRule != nil {
+			return domain.ErrInvalidInput
+		}
+		return nil
+	}
+	rule, err := newEventRule(*event, calendar)
+	if err != nil {
+		return err
+	}
+	if rule.calendar != nil {
+		if _, err := rule.calendar.holidaysOn(truncateToDate(event.StartDate)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newEventRule イベントの RRULE を解析し、営業日に基づく繰り返しの指定があれば休日の判定を加える
+func newEventRule(event domain.Event, calendar HolidayCalendarInterface) (*recurrenceRule, error) {
+	rule, err := parseRRule(event.RRule, eventLocation(event))
+	if err != nil || event.BusinessRule == nil {
+		return rule, err
+	}
+	if calendar == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	br := event.BusinessRule
+	switch br.Shift {
+	case "", domain.RecurrenceShiftPrevious, domain.RecurrenceShiftNext, domain.RecurrenceShiftNone:
+	default:
+		return nil, domain.ErrInvalidInput
+	}
+	if br.BusinessDay != 0 {
+		// 第N営業日は月ごとに数えるため、日付を選ぶ他の指定とは組み合わせない
+		if br.BusinessDay > maxNthBusinessDay || br.BusinessDay < -maxNthBusinessDay {
+			return nil, domain.ErrInvalidInput
+		}
+		if (rule.Freq != "MONTHLY" && rule.Freq != "YEARLY") || len(rule.ByDay) > 0 || len(rule.ByMonthDay) > 0 || len(rule.BySetPos) > 0 {
+			return nil, domain.ErrInvalidInput
+		}
+	}
+
+	bc, err := NewBusinessDayService(calendar).newBusinessCalendar(domain.BusinessDayOptions{
+		Weekend:       br.Weekend,
+		Regions:       br.Regions,
+		IncludeCustom: br.IncludeCustom,
+	})
+	if err != nil {
+		return nil, err
+	}
+	rule.BusinessDay = br.BusinessDay
+	rule.calendar = bc
+	return rule, nil
+}
+
+// sameOccurrence 2つの日時が同じ回を指すか（終日イベントはイベントのタイムゾーンでの日付で比べる）
+func sameOccurrence(a, b time.Time, allDay bool, loc *time.Location) bool {
+	if a.Equal(b) {
+		return true
+	}
+	if !allDay {
+		return false
+	}
+	ay, am, ad := a.In(loc).Date()
+	by, bm, bd := b.In(loc).Date()
+	return ay == by && am == bm && ad == bd
+}
+
+// occurrenceTime 繰り返しの回の本来の開始日時（ID）と実際の開始日時（Start）
+// ID は RecurrenceID・EXDATE・UNTIL・COUNT の基準で、Start は休業日の移動を反映した日時（移動しない場合は ID と同じ）
+type occurrenceTime struct {
+	ID    time.Time
+	Start time.Time
+}
+
+// eventOccurrences 繰り返しイベントの開始日時が horizon までの回（RDATE を含み EXDATE を除く、開始日時の古い順）
+// EXDATE は回の本来の開始日時と比べる
+func eventOccurrences(event domain.Event, horizon time.Time, calendar HolidayCalendarInterface) ([]occurrenceTime, error) {
+	loc := eventLocation(event)
+	dtstart := event.StartDate.In(loc)
+
+	occurrences := []occurrenceTime{}
+	if event.RRule != "" {
+		rule, err := newEventRule(event, calendar)
+		if err == domain.ErrInvalidInput {
+			// 保存時に検証しているため、解析できない規則は開始日時の1回だけとする
+			occurrences = append(occurrences, occurrenceTime{ID: dtstart, Start: dtstart})
+		} else if err != nil {
+			return nil, err
+		} else {
+			ruleOccurrences, err := ruleOccurrences(event, rule, dtstart, horizon)
+			if err != nil {
+				return nil, err
+			}
+			occurrences = append(occurrences, ruleOccurrences...)
+		}
+	} else if !dtstart.After(horizon) {
+		occurrences = append(occurrences, occurrenceTime{ID: dtstart, Start: dtstart})
+	}
+	for _, rdate := range event.RDates {
+		if !rdate.After(horizon) {
+			occurrences = append(occurrences, occurrenceTime{ID: rdate.In(loc), Start: rdate.In(loc)})
+		}
+	}
+	sort.SliceStable(occurrences, func(i, j int) bool {
+		return occurrences[i].Start.Before(occurrences[j].Start)
+	})
+
+	result := []occurrenceTime{}
+	for i, occurrence := range occurrences {
+		if i > 0 && occurrences[i-1].Start.Equal(occurrence.Start) {
+			continue
+		}
+		excluded := false
+		for _, exdate := range event.ExDates {
+			if sameOccurrence(occurrence.ID, exdate, event.AllDay, loc) {
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			result = append(result, occurrence)
+		}
+	}
+	return result, nil
+}
+
+// occurrenceAt 繰り返しの回からその回のイベントを作る
+// 終了日時は最初の回と同じ日数後の同じ時刻（タイムゾーンでの壁時計の時刻）とする
+func occurrenceAt(event domain.Event, at occurrenceTime) domain.Event {
+	loc := eventLocation(event)
+	first := event.StartDate.In(loc)
+	end := event.EndDate.In(loc)
+
+	firstDate := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(endDate.Sub(firstDate).Hours() / 24)
+
+	start := at.Start.In(loc)
+	occurrence := event
+	occurrence.StartDate = start
+	occurrence.EndDate = time.Date(start.Year(), start.Month(), start.Day()+days, end.Hour(), end.Minute(), end.Second(), end.Nanosecond(), loc)
+	if occurrence.EndDate.Before(start) {
+		occurrence.EndDate = start
+	}
+	recurrenceID := at.ID.In(loc)
+	occurrence.RecurrenceID = &recurrenceID
+	return occurrence
+}
+
+// findOccurrence 繰り返しイベントの回のうち本来の開始日時が recurrenceID に当たるものを探す
+func findOccurrence(event domain.Event, recurrenceID time.Time, calendar HolidayCalendarInterface) (domain.Event, bool, error) {
+	loc := eventLocation(event)
+	// 翌営業日に移った回も見つかるように、その回を移した日時まで展開する
+	horizon := recurrenceID
+	if step := recurrenceShiftStep(event.BusinessRule); step > 0 && event.RRule != "" {
+		rule, err := newEventRule(event, calendar)
+		if err != nil && err != domain.ErrInvalidInput {
+			return domain.Event{}, false, err
+		}
+		if err == nil {
+			if horizon, err = shiftOccurrence(rule.calendar, recurrenceID.In(loc), step); err != nil {
+				return domain.Event{}, false, err
+			}
+		}
+	}
+	occurrences, err := eventOccurrences(event, horizon.AddDate(0, 0, 1), calendar)
+	if err != nil {
+		return domain.Event{}, false, err
+	}
+	for _, occurrence := range occurrences {
+		if sameOccurrence(occurrence.ID, recurrenceID, event.AllDay, loc) {
+			return occurrenceAt(event, occurrence), true, nil
+		}
+	}
+	return domain.Event{}, false, nil
+}
+
+// countOccurrencesBefore RRULE に従う回のうち本来の開始日時が before より前の回の数（EXDATE で除いた回も数える）
+func countOccurrencesBefore(event domain.Event, rule *recurrenceRule, before time.Time) (int, error) {
+	count := 0
+	rule.occurrences(event.StartDate.In(eventLocation(event)), before, func(t time.Time) bool {
+		if !t.Before(before) {
+			return false
+		}
+		count++
+		return true
+	})
+	if rule.err != nil {
+		return 0, rule.err
+	}
+	return count, nil
+}
+
+// expandEvents 繰り返しイベントを期間内（start〜end と重なる）の各回に展開し、開始日時順に並べる
+// 繰り返しのないイベントはそのまま返す。calendar は営業日に基づく繰り返しの休日の判定に使う
+func expandEvents(events []domain.Event, start, end time.Time, calendar HolidayCalendarInterface) ([]domain.Event, error) {
+	expanded := []domain.Event{}
+	for _, event := range events {
+		if !isRecurringEvent(event) {
+			expanded = append(expanded, event)
+			continue
+		}
+		occurrences, err := eventOccurrences(event, end, calendar)
+		if err != nil {
+			return nil, err
+		}
+		for _, at := range occurrences {
+			occurrence := occurrenceAt(event, at)
+			if !occurrence.EndDate.Before(start) {
+				expanded = append(expanded, occurrence)
+			}
+		}
+	}
+
+	sort.SliceStable(expanded, func(i, j int) bool {
+		return expanded[i].StartDate.Before(expanded[j].StartDate)
+	})
+	return expanded, nil
+}
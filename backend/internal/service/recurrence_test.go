@@ -0,0 +1,244 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// occurrenceDates 期間内の各回の開始日時をイベントのタイムゾーンで "2006-01-02 15:04" 形式にする
+func occurrenceDates(event domain.Event, start, end time.Time) []string {
+	dates := []string{}
+	events, _ := expandEvents([]domain.Event{event}, start, end, nil)
+	for _, occurrence := range events {
+		dates = append(dates, occurrence.StartDate.In(eventLocation(event)).Format("2006-01-02 15:04"))
+	}
+	return dates
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestParseRRule_Invalid(t *testing.T) {
+	rules := []string{
+		"",
+		"INTERVAL=2",
+		"FREQ=HOURLY",
+		"FREQ=WEEKLY;COUNT=2;UNTIL=20250101",
+		"FREQ=WEEKLY;COUNT=0",
+		"FREQ=WEEKLY;INTERVAL=0",
+		"FREQ=DAILY;BYDAY=1MO",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=WEEKLY;BYMONTHDAY=1",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=YEARLY;BYMONTH=13",
+		"FREQ=MONTHLY;BYSETPOS=1",
+		"FREQ=WEEKLY;BYHOUR=9",
+		"FREQ=WEEKLY;FREQ=DAILY",
+		"FREQ=WEEKLY;;COUNT=2",
+		"FREQ=WEEKLY;UNTIL=2025-01-01",
+	}
+	for _, rule := range rules {
+		if _, err := parseRRule(rule, time.UTC); err != domain.ErrInvalidInput {
+			t.Errorf("%q: Expected ErrInvalidInput, got %v", rule, err)
+		}
+	}
+
+	if _, err := parseRRule("RRULE:freq=weekly;byday=mo,we;wkst=su", time.UTC); err != nil {
+		t.Errorf("Expected prefixed lower-case rule to be valid, got %v", err)
+	}
+}
+
+func TestExpandEvents_Rules(t *testing.T) {
+	jst, _ := time.LoadLocation("Asia/Tokyo")
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, jst)
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		rrule    string
+		end      time.Time
+		expected []string
+	}{
+		{
+			name:     "毎週月・水曜日を5回",
+			start:    time.Date(2025, 1, 6, 10, 0, 0, 0, jst),
+			rrule:    "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=5",
+			end:      time.Date(2025, 12, 31, 0, 0, 0, 0, jst),
+			expected: []string{"2025-01-06 10:00", "2025-01-08 10:00", "2025-01-13 10:00", "2025-01-15 10:00", "2025-01-20 10:00"},
+		},
+		{
+			name:     "隔週",
+			start:    time.Date(2025, 1, 6, 10, 0, 0, 0, jst),
+			rrule:    "FREQ=WEEKLY;INTERVAL=2",
+			end:      time.Date(2025, 2, 10, 0, 0, 0, 0, jst),
+			expected: []string{"2025-01-06 10:00", "2025-01-20 10:00", "2025-02-03 10:00"},
+		},
+		{
+			name:     "毎月第2火曜日",
+			start:    time.Date(2025, 1, 14, 15, 0, 0, 0, jst),
+			rrule:    "FREQ=MONTHLY;BYDAY=2TU",
+			end:      time.Date(2025, 4, 30, 0, 0, 0, 0, jst),
+			expected: []string{"2025-01-14 15:00", "2025-02-11 15:00", "2025-03-11 15:00", "2025-04-08 15:00"},
+		},
+		{
+			name:     "毎月最終平日",
+			start:    time.Date(2025, 1, 31, 9, 0, 0, 0, jst),
+			rrule:    "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1",
+			end:      time.Date(2025, 4, 30, 23, 0, 0, 0, jst),
+			expected: []string{"2025-01-31 09:00", "2025-02-28 09:00", "2025-03-31 09:00", "2025-04-30 09:00"},
+		},
+		{
+			name:     "毎月31日（31日のない月は飛ばす）",
+			start:    time.Date(2025, 1, 31, 9, 0, 0, 0, jst),
+			rrule:    "FREQ=MONTHLY",
+			end:      time.Date(2025, 6, 30, 0, 0, 0, 0, jst),
+			expected: []string{"2025-01-31 09:00", "2025-03-31 09:00", "2025-05-31 09:00"},
+		},
+		{
+			name:     "毎年11月の第4木曜日",
+			start:    time.Date(2025, 11, 27, 12, 0, 0, 0, jst),
+			rrule:    "FREQ=YEARLY;BYMONTH=11;BYDAY=4TH",
+			end:      time.Date(2027, 12, 31, 0, 0, 0, 0, jst),
+			expected: []string{"2025-11-27 12:00", "2026-11-26 12:00", "2027-11-25 12:00"},
+		},
+		{
+			name:     "日付のみの UNTIL はその日を含む",
+			start:    time.Date(2025, 1, 1, 8, 0, 0, 0, jst),
+			rrule:    "FREQ=DAILY;UNTIL=20250103",
+			end:      time.Date(2025, 12, 31, 0, 0, 0, 0, jst),
+			expected: []string{"2025-01-01 08:00", "2025-01-02 08:00", "2025-01-03 08:00"},
+		},
+	}
+
+	for _, test := range tests {
+		event := domain.Event{
+			ID:        1,
+			Title:     test.name,
+			StartDate: test.start,
+			EndDate:   test.start.Add(time.Hour),
+			TimeZone:  "Asia/Tokyo",
+			RRule:     test.rrule,
+		}
+		got := occurrenceDates(event, from, test.end)
+		if !equalStrings(got, test.expected) {
+			t.Errorf("%s: Expected %v, got %v", test.name, test.expected, got)
+		}
+	}
+}
+
+func TestExpandEvents_ExDatesAndRDates(t *testing.T) {
+	jst, _ := time.LoadLocation("Asia/Tokyo")
+	event := domain.Event{
+		ID:        1,
+		Title:     "定例会",
+		StartDate: time.Date(2025, 1, 6, 10, 0, 0, 0, jst),
+		EndDate:   time.Date(2025, 1, 6, 11, 0, 0, 0, jst),
+		TimeZone:  "Asia/Tokyo",
+		RRule:     "FREQ=WEEKLY;COUNT=4",
+		ExDates:   []time.Time{time.Date(2025, 1, 13, 1, 0, 0, 0, time.UTC)},
+		RDates:    []time.Time{time.Date(2025, 1, 14, 10, 0, 0, 0, jst)},
+	}
+
+	got := occurrenceDates(event, time.Date(2025, 1, 1, 0, 0, 0, 0, jst), time.Date(2025, 3, 1, 0, 0, 0, 0, jst))
+	expected := []string{"2025-01-06 10:00", "2025-01-14 10:00", "2025-01-20 10:00", "2025-01-27 10:00"}
+	if !equalStrings(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestExpandEvents_Range(t *testing.T) {
+	jst, _ := time.LoadLocation("Asia/Tokyo")
+	series := domain.Event{
+		ID:        1,
+		Title:     "夜間メンテナンス",
+		StartDate: time.Date(2025, 1, 6, 23, 0, 0, 0, jst),
+		EndDate:   time.Date(2025, 1, 7, 2, 0, 0, 0, jst),
+		TimeZone:  "Asia/Tokyo",
+		RRule:     "FREQ=WEEKLY",
+	}
+	single := domain.Event{
+		ID:        2,
+		Title:     "単発",
+		StartDate: time.Date(2025, 2, 4, 9, 0, 0, 0, jst),
+		EndDate:   time.Date(2025, 2, 4, 10, 0, 0, 0, jst),
+		TimeZone:  "Asia/Tokyo",
+	}
+
+	// 2月4日0時〜: 2月3日23時に始まる回は期間と重なるため含める
+	events, err := expandEvents([]domain.Event{series, single},
+		time.Date(2025, 2, 4, 0, 0, 0, 0, jst), time.Date(2025, 2, 10, 23, 59, 59, 0, jst), nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("Expected 3 events, got %d: %+v", len(events), events)
+	}
+
+	if events[0].ID != 1 || !events[0].StartDate.Equal(time.Date(2025, 2, 3, 23, 0, 0, 0, jst)) || !events[0].EndDate.Equal(time.Date(2025, 2, 4, 2, 0, 0, 0, jst)) {
+		t.Errorf("Unexpected first occurrence: %+v", events[0])
+	}
+	if events[0].RecurrenceID == nil || !events[0].RecurrenceID.Equal(events[0].StartDate) {
+		t.Errorf("Expected recurrence_id to be the occurrence start, got %v", events[0].RecurrenceID)
+	}
+	if events[1].ID != 2 || events[1].RecurrenceID != nil {
+		t.Errorf("Expected the single event unchanged, got %+v", events[1])
+	}
+	if !events[2].StartDate.Equal(time.Date(2025, 2, 10, 23, 0, 0, 0, jst)) {
+		t.Errorf("Unexpected last occurrence: %+v", events[2])
+	}
+}
+
+func TestExpandEvents_DaylightSavingTime(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	event := domain.Event{
+		ID:        1,
+		Title:     "Standup",
+		StartDate: time.Date(2025, 3, 3, 9, 0, 0, 0, ny),
+		EndDate:   time.Date(2025, 3, 3, 9, 15, 0, 0, ny),
+		TimeZone:  "America/New_York",
+		RRule:     "FREQ=WEEKLY;COUNT=2",
+	}
+
+	// 夏時間の開始（3月9日）をまたいでも現地時刻の9時に行う
+	events, err := expandEvents([]domain.Event{event}, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 occurrences, got %d", len(events))
+	}
+	if !events[0].StartDate.Equal(time.Date(2025, 3, 3, 14, 0, 0, 0, time.UTC)) || !events[1].StartDate.Equal(time.Date(2025, 3, 10, 13, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected 14:00Z and 13:00Z, got %v and %v", events[0].StartDate.UTC(), events[1].StartDate.UTC())
+	}
+	if events[1].EndDate.Sub(events[1].StartDate) != 15*time.Minute {
+		t.Errorf("Expected 15 minute occurrence, got %v", events[1].EndDate.Sub(events[1].StartDate))
+	}
+}
+
+func TestReplaceRRuleParts(t *testing.T) {
+	tests := []struct {
+		rule     string
+		parts    map[string]string
+		expected string
+	}{
+		{"FREQ=WEEKLY;COUNT=10;BYDAY=MO", map[string]string{"COUNT": "3"}, "FREQ=WEEKLY;COUNT=3;BYDAY=MO"},
+		{"RRULE:FREQ=WEEKLY;BYDAY=MO", map[string]string{"UNTIL": "20250120T005959Z"}, "FREQ=WEEKLY;BYDAY=MO;UNTIL=20250120T005959Z"},
+		{"FREQ=WEEKLY;COUNT=10", map[string]string{"COUNT": ""}, "FREQ=WEEKLY"},
+	}
+	for _, test := range tests {
+		if got := replaceRRuleParts(test.rule, test.parts); got != test.expected {
+			t.Errorf("%q: Expected %q, got %q", test.rule, test.expected, got)
+		}
+	}
+}
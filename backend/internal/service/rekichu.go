@@ -0,0 +1,139 @@
+package service
+
+import (
+	"math"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+var (
+	// heavenlyStems 十干
+	heavenlyStems = []string{"甲", "乙", "丙", "丁", "戊", "己", "庚", "辛", "壬", "癸"}
+	// earthlyBranches 十二支
+	earthlyBranches = []string{"子", "丑", "寅", "卯", "辰", "巳", "午", "未", "申", "酉", "戌", "亥"}
+)
+
+// 十二支の番号
+const (
+	branchRat = iota
+	branchOx
+	branchTiger
+	branchRabbit
+	branchDragon
+	branchSnake
+	branchHorse
+	branchGoat
+	branchMonkey
+	branchRooster
+	branchDog
+	branchBoar
+)
+
+// 選日の名称
+const (
+	senjitsuIchiryuManbai = "一粒万倍日"
+	senjitsuTensha        = "天赦日"
+	senjitsuFujoju        = "不成就日"
+	senjitsuTora          = "寅の日"
+	senjitsuMi            = "巳の日"
+	senjitsuTsuchinotoMi  = "己巳の日"
+	senjitsuKinoeNe       = "甲子の日"
+)
+
+// ichiryuManbaiBranches 節月（0=寅月 … 11=丑月）ごとの一粒万倍日となる日の十二支
+var ichiryuManbaiBranches = [12][2]int{
+	{branchOx, branchHorse},
+	{branchRooster, branchTiger},
+	{branchRat, branchRabbit},
+	{branchRabbit, branchDragon},
+	{branchSnake, branchHorse},
+	{branchRooster, branchHorse},
+	{branchRat, branchGoat},
+	{branchRabbit, branchMonkey},
+	{branchRooster, branchHorse},
+	{branchRooster, branchDog},
+	{branchBoar, branchRat},
+	{branchRabbit, branchRat},
+}
+
+// tenshaDays 季節（春・夏・秋・冬）ごとの天赦日の干支番号（戊寅・甲午・戊申・甲子）
+var tenshaDays = [4]int{14, 30, 44, 0}
+
+// fujojuFirstDays 旧暦の月（1～12）ごとの最初の不成就日。以後8日おきに巡る
+var fujojuFirstDays = [13]int{0, 3, 2, 1, 4, 5, 6, 3, 2, 1, 4, 5, 6}
+
+// GetRekichu 指定年の選日を日付順に取得
+func (s *CalendarService) GetRekichu(year int) ([]domain.Rekichu, error) {
+	if err := validateYear(year); err != nil {
+		return nil, err
+	}
+
+	rekichu := []domain.Rekichu{}
+
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		eto := sexagenaryName(sexagenaryDayIndex(d))
+		for _, name := range calculateSenjitsu(d, toLunarDate(d)) {
+			rekichu = append(rekichu, domain.Rekichu{Date: d, Name: name, Eto: eto})
+		}
+	}
+
+	return rekichu, nil
+}
+
+// calculateSenjitsu 指定日の選日を計算
+func calculateSenjitsu(date time.Time, lunar domain.LunarDate) []string {
+	senjitsu := []string{}
+
+	index := sexagenaryDayIndex(date)
+	branch := index % 12
+	month := solarMonthIndex(date)
+
+	if branch == ichiryuManbaiBranches[month][0] || branch == ichiryuManbaiBranches[month][1] {
+		senjitsu = append(senjitsu, senjitsuIchiryuManbai)
+	}
+	if index == tenshaDays[month/3] {
+		senjitsu = append(senjitsu, senjitsuTensha)
+	}
+	if lunar.Day >= fujojuFirstDays[lunar.Month] && (lunar.Day-fujojuFirstDays[lunar.Month])%8 == 0 {
+		senjitsu = append(senjitsu, senjitsuFujoju)
+	}
+	switch {
+	case branch == branchTiger:
+		senjitsu = append(senjitsu, senjitsuTora)
+	case index == 5:
+		senjitsu = append(senjitsu, senjitsuTsuchinotoMi)
+	case branch == branchSnake:
+		senjitsu = append(senjitsu, senjitsuMi)
+	case index == 0:
+		senjitsu = append(senjitsu, senjitsuKinoeNe)
+	}
+
+	return senjitsu
+}
+
+// solarMonthIndex 節月の番号（0=寅月〔立春～〕 … 11=丑月〔小寒～〕）
+// 節入りの日はその日から新しい月とするため、翌日0時（日本時間）の太陽黄経で判定する
+func solarMonthIndex(date time.Time) int {
+	longitude := sunLongitude(julianDay(jstMidnight(date.AddDate(0, 0, 1))))
+	return int(normalizeAngle(longitude-315) / 30)
+}
+
+// sexagenaryName 干支の番号から名称を取得（0=甲子 … 59=癸亥）
+func sexagenaryName(index int) string {
+	return heavenlyStems[index%10] + earthlyBranches[index%12]
+}
+
+// earthlyBranchIndex 日の十二支の番号（0=子, 1=丑, …, 11=亥）
+func earthlyBranchIndex(date time.Time) int {
+	return sexagenaryDayIndex(date) % 12
+}
+
+// sexagenaryDayIndex 日の干支の番号（0=甲子 … 59=癸亥）
+// 2000年1月1日（ユリウス通日2451545）が戊午（54）であることを基準とする
+func sexagenaryDayIndex(date time.Time) int {
+	jdn := int(math.Floor(julianDay(date) + 0.5))
+	return ((jdn+49)%60 + 60) % 60
+}
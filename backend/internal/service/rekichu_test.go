@@ -0,0 +1,121 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+func TestSexagenaryDayIndex(t *testing.T) {
+	tests := []struct {
+		date     time.Time
+		expected string
+	}{
+		{time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "戊午"},
+		{time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), "甲子"},
+		{time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC), "甲辰"},
+	}
+
+	for _, test := range tests {
+		if got := sexagenaryName(sexagenaryDayIndex(test.date)); got != test.expected {
+			t.Errorf("%v: expected %s, got %s", test.date.Format("2006-01-02"), test.expected, got)
+		}
+	}
+}
+
+func TestCalculateSenjitsu(t *testing.T) {
+	// 2024年1月1日は甲子で、天赦日と一粒万倍日が重なる
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	senjitsu := calculateSenjitsu(date, toLunarDate(date))
+
+	expected := map[string]bool{"一粒万倍日": false, "天赦日": false, "甲子の日": false}
+	for _, name := range senjitsu {
+		if _, ok := expected[name]; ok {
+			expected[name] = true
+		}
+	}
+	for name, found := range expected {
+		if !found {
+			t.Errorf("2024-01-01 should include %s, got %v", name, senjitsu)
+		}
+	}
+}
+
+func TestCalendarService_GetRekichu_Tensha(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+	rekichu, err := service.GetRekichu(2025)
+	if err != nil {
+		t.Fatalf("Failed to get rekichu: %v", err)
+	}
+
+	// 2025年の天赦日（立秋当日の8月7日は秋の節月として扱う）
+	expected := []string{"2025-03-10", "2025-05-25", "2025-07-24", "2025-08-07", "2025-10-06", "2025-12-21"}
+
+	got := []string{}
+	for _, r := range rekichu {
+		if r.Name == "天赦日" {
+			got = append(got, r.Date.Format("2006-01-02"))
+		}
+	}
+
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("Expected %s, got %s", expected[i], got[i])
+		}
+	}
+}
+
+func TestCalendarService_GetRekichu_Fujoju(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+	rekichu, err := service.GetRekichu(2025)
+	if err != nil {
+		t.Fatalf("Failed to get rekichu: %v", err)
+	}
+
+	// 旧暦12月は6日から8日おき、旧暦1月は3日から8日おきが不成就日
+	for _, r := range rekichu {
+		if r.Name != "不成就日" {
+			continue
+		}
+		lunar := toLunarDate(r.Date)
+		if (lunar.Day-fujojuFirstDays[lunar.Month])%8 != 0 {
+			t.Errorf("%v (旧暦%d月%d日) should not be 不成就日", r.Date, lunar.Month, lunar.Day)
+		}
+	}
+
+	found := false
+	for _, r := range rekichu {
+		if r.Name == "不成就日" && r.Date.Equal(time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)) {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("2025-01-31 (旧暦1月3日) should be 不成就日")
+	}
+}
+
+func TestCalendarService_GetCalendar_Rekichu(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+
+	calendar, err := service.GetCalendar(2024, 1, domain.CalendarOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get calendar: %v", err)
+	}
+
+	day := calendar.Days[0]
+	if day.Eto != "甲子" {
+		t.Errorf("Expected eto 甲子, got %s", day.Eto)
+	}
+	if len(day.Senjitsu) == 0 {
+		t.Error("2024-01-01 should have senjitsu")
+	}
+	for _, d := range calendar.Days {
+		if d.Senjitsu == nil {
+			t.Errorf("Senjitsu should not be nil on %v", d.Date)
+		}
+	}
+}
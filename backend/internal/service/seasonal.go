@@ -0,0 +1,77 @@
+package service
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// solarTermNames 二十四節気の名称（春分＝太陽黄経0度から15度刻み）
+var solarTermNames = [24]string{
+	"春分", "清明", "穀雨", "立夏", "小満", "芒種",
+	"夏至", "小暑", "大暑", "立秋", "処暑", "白露",
+	"秋分", "寒露", "霜降", "立冬", "小雪", "大雪",
+	"冬至", "小寒", "大寒", "立春", "雨水", "啓蟄",
+}
+
+// GetSeasonalDays 指定年の二十四節気と雑節を日付順に取得
+func (s *CalendarService) GetSeasonalDays(year int) []domain.SeasonalDay {
+	days := []domain.SeasonalDay{}
+
+	terms := make(map[float64]time.Time, len(solarTermNames))
+	for i, name := range solarTermNames {
+		longitude := float64(i * 15)
+		date := solarTermDay(year, longitude)
+		terms[longitude] = date
+		days = append(days, domain.SeasonalDay{Date: date, Name: name, Type: domain.SeasonalTypeSolarTerm})
+	}
+
+	zassetsu := func(date time.Time, name string) {
+		days = append(days, domain.SeasonalDay{Date: date, Name: name, Type: domain.SeasonalTypeZassetsu})
+	}
+
+	risshun := terms[315]
+	zassetsu(risshun.AddDate(0, 0, -1), "節分")
+	zassetsu(terms[0].AddDate(0, 0, -3), "彼岸入り")
+	zassetsu(terms[0].AddDate(0, 0, 3), "彼岸明け")
+	zassetsu(risshun.AddDate(0, 0, 87), "八十八夜")
+	zassetsu(solarTermDay(year, 80), "入梅")
+	zassetsu(solarTermDay(year, 100), "半夏生")
+	zassetsu(risshun.AddDate(0, 0, 209), "二百十日")
+	zassetsu(risshun.AddDate(0, 0, 219), "二百二十日")
+	zassetsu(terms[180].AddDate(0, 0, -3), "彼岸入り")
+	zassetsu(terms[180].AddDate(0, 0, 3), "彼岸明け")
+
+	// 土用は立春・立夏・立秋・立冬の前の約18日間で、太陽黄経が27度・117度・207度・297度の日に入る
+	for _, doyo := range []struct{ start, end float64 }{{297, 315}, {27, 45}, {117, 135}, {207, 225}} {
+		start := solarTermDay(year, doyo.start)
+		zassetsu(start, "土用入り")
+		for d := start; d.Before(terms[doyo.end]); d = d.AddDate(0, 0, 1) {
+			if earthlyBranchIndex(d) == 1 {
+				zassetsu(d, "土用の丑")
+			}
+		}
+	}
+
+	sort.SliceStable(days, func(i, j int) bool {
+		return days[i].Date.Before(days[j].Date)
+	})
+
+	return days
+}
+
+// solarTermDay 指定年において太陽黄経が指定角度となる日本時間の日付
+func solarTermDay(year int, longitude float64) time.Time {
+	return solarTermDayIn(year, longitude, jst)
+}
+
+// solarTermDayIn 指定年において太陽黄経が指定角度となる日付（指定タイムゾーン）
+func solarTermDayIn(year int, longitude float64, loc *time.Location) time.Time {
+	// 春分（3月20日頃）を基準に、小寒以降（285度～）は同じ年の1～3月となるよう近似日を求める
+	offset := math.Mod(longitude+75, 360) - 75
+	guess := time.Date(year, time.March, 20, 0, 0, 0, 0, time.UTC).
+		Add(time.Duration(offset / 360 * tropicalYear * float64(24*time.Hour)))
+	return dateIn(solarTermTime(longitude, guess), loc)
+}
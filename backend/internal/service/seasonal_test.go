@@ -0,0 +1,106 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+// findSeasonalDays は指定日の二十四節気・雑節の名称を返す
+func findSeasonalDays(days []domain.SeasonalDay, year, month, day int) []string {
+	target := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	names := []string{}
+	for _, d := range days {
+		if d.Date.Equal(target) {
+			names = append(names, d.Name)
+		}
+	}
+	return names
+}
+
+func TestCalendarService_GetSeasonalDays(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+	days := service.GetSeasonalDays(2025)
+
+	// 国立天文台 暦要項（2025年）による日付
+	tests := []struct {
+		month int
+		day   int
+		name  string
+	}{
+		{1, 5, "小寒"},
+		{1, 17, "土用入り"},
+		{1, 20, "大寒"},
+		{2, 2, "節分"},
+		{2, 3, "立春"},
+		{3, 17, "彼岸入り"},
+		{3, 20, "春分"},
+		{5, 1, "八十八夜"},
+		{5, 5, "立夏"},
+		{6, 11, "入梅"},
+		{6, 21, "夏至"},
+		{7, 1, "半夏生"},
+		{7, 19, "土用入り"},
+		{7, 19, "土用の丑"},
+		{7, 31, "土用の丑"},
+		{8, 7, "立秋"},
+		{8, 31, "二百十日"},
+		{9, 10, "二百二十日"},
+		{9, 20, "彼岸入り"},
+		{9, 23, "秋分"},
+		{12, 22, "冬至"},
+	}
+
+	for _, test := range tests {
+		names := findSeasonalDays(days, 2025, test.month, test.day)
+		found := false
+		for _, name := range names {
+			if name == test.name {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("2025-%02d-%02d should include %s, got %v", test.month, test.day, test.name, names)
+		}
+	}
+}
+
+func TestCalendarService_GetSeasonalDays_SolarTermCount(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+
+	count := 0
+	for _, d := range service.GetSeasonalDays(2025) {
+		if d.Type == domain.SeasonalTypeSolarTerm {
+			count++
+			if d.Date.Year() != 2025 {
+				t.Errorf("%s should be in 2025, got %v", d.Name, d.Date)
+			}
+		}
+	}
+
+	if count != 24 {
+		t.Errorf("Expected 24 solar terms, got %d", count)
+	}
+}
+
+func TestCalendarService_GetCalendar_SeasonalDays(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+
+	calendar, err := service.GetCalendar(2025, 7, domain.CalendarOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get calendar: %v", err)
+	}
+
+	// 2025年7月19日は土用入りと土用の丑が重なる
+	day := calendar.Days[18]
+	if len(day.SeasonalDays) != 2 {
+		t.Errorf("Expected 2 seasonal annotations on 2025-07-19, got %v", day.SeasonalDays)
+	}
+
+	for _, d := range calendar.Days {
+		if d.SeasonalDays == nil {
+			t.Errorf("SeasonalDays should not be nil on %v", d.Date)
+		}
+	}
+}
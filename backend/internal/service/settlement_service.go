@@ -0,0 +1,123 @@
+package service
+
+import (
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+type SettlementService struct {
+	businessDays *BusinessDayService
+}
+
+func NewSettlementService(calendar HolidayCalendarInterface) *SettlementService {
+	return &SettlementService{businessDays: NewBusinessDayService(calendar)}
+}
+
+// GetSettlementSchedule 指定年の各月の締め日と支払日を求める
+// 締め日は休業日でも移動せず、支払日のみ規則に従って前営業日または翌営業日に移動する
+func (s *SettlementService) GetSettlementSchedule(year int, rule domain.SettlementRule, opts domain.BusinessDayOptions) (*domain.SettlementSchedule, error) {
+	if rule.Shift == "" {
+		rule.Shift = domain.SettlementShiftPrevious
+	}
+	if err := validateSettlementRule(rule); err != nil {
+		return nil, err
+	}
+
+	bc, err := s.businessDays.newBusinessCalendar(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := &domain.SettlementSchedule{
+		Year:    year,
+		Rule:    rule,
+		Entries: []domain.SettlementEntry{},
+	}
+
+	for month := time.January; month <= time.December; month++ {
+		closing := dayOfMonth(year, month, rule.ClosingDay)
+		previous := dayOfMonth(year, month-1, rule.ClosingDay)
+		scheduled := dayOfMonth(year, month+time.Month(rule.PaymentMonthOffset), rule.PaymentDay)
+
+		payment, err := shiftToBusinessDay(bc, scheduled, rule.Shift)
+		if err != nil {
+			return nil, err
+		}
+
+		schedule.Entries = append(schedule.Entries, domain.SettlementEntry{
+			PeriodStart:          previous.AddDate(0, 0, 1),
+			ClosingDate:          closing,
+			ScheduledPaymentDate: scheduled,
+			PaymentDate:          payment,
+			Shifted:              !payment.Equal(scheduled),
+		})
+	}
+
+	return schedule, nil
+}
+
+// validateSettlementRule 締め日・支払日の規則を検証
+// 当月払いの場合は支払日が締め日より前にならないこと
+func validateSettlementRule(rule domain.SettlementRule) error {
+	if rule.ClosingDay < 0 || rule.ClosingDay > 31 || rule.PaymentDay < 0 || rule.PaymentDay > 31 {
+		return domain.ErrInvalidInput
+	}
+	if rule.PaymentMonthOffset < 0 || rule.PaymentMonthOffset > 12 {
+		return domain.ErrInvalidInput
+	}
+	switch rule.Shift {
+	case domain.SettlementShiftPrevious, domain.SettlementShiftNext, domain.SettlementShiftNone:
+	default:
+		return domain.ErrInvalidInput
+	}
+	if rule.PaymentMonthOffset == 0 && monthDayOrEnd(rule.PaymentDay) < monthDayOrEnd(rule.ClosingDay) {
+		return domain.ErrInvalidInput
+	}
+	return nil
+}
+
+// monthDayOrEnd 0（月末）を31として扱った日
+func monthDayOrEnd(day int) int {
+	if day == 0 {
+		return 31
+	}
+	return day
+}
+
+// dayOfMonth 指定月の day 日（0 または月の日数を超える場合は月末）
+// month が範囲外の場合は前年・翌年の月として扱う
+func dayOfMonth(year int, month time.Month, day int) time.Time {
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC)
+	if day == 0 || day >= lastDay.Day() {
+		return lastDay
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// shiftToBusinessDay 休業日であれば前営業日または翌営業日に移動する
+func shiftToBusinessDay(bc *businessCalendar, date time.Time, shift string) (time.Time, error) {
+	switch shift {
+	case domain.SettlementShiftPrevious:
+		return moveToBusinessDay(bc, date, -1)
+	case domain.SettlementShiftNext:
+		return moveToBusinessDay(bc, date, 1)
+	}
+	return date, nil
+}
+
+// moveToBusinessDay 休業日であれば step（-1 または 1）の方向に営業日まで移動する
+func moveToBusinessDay(bc *businessCalendar, date time.Time, step int) (time.Time, error) {
+	for i := 0; i < domain.MaxBusinessDaySearch; i++ {
+		ok, err := bc.isBusinessDay(date)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if ok {
+			return date, nil
+		}
+		date = date.AddDate(0, 0, step)
+	}
+
+	return time.Time{}, domain.ErrNotFound
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+)
+
+func TestSettlementService_GetSettlementSchedule(t *testing.T) {
+	service := NewSettlementService(NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{}))
+
+	// 月末締め翌月25日払い、休日なら前営業日
+	rule := domain.SettlementRule{ClosingDay: 0, PaymentMonthOffset: 1, PaymentDay: 25, Shift: domain.SettlementShiftPrevious}
+	schedule, err := service.GetSettlementSchedule(2025, rule, domain.BusinessDayOptions{})
+	if err != nil {
+		t.Fatalf("GetSettlementSchedule should not return error: %v", err)
+	}
+
+	if len(schedule.Entries) != 12 {
+		t.Fatalf("Expected 12 entries, got %d", len(schedule.Entries))
+	}
+
+	tests := []struct {
+		index     int
+		start     string
+		closing   string
+		scheduled string
+		payment   string
+	}{
+		{0, "2025-01-01", "2025-01-31", "2025-02-25", "2025-02-25"},
+		{1, "2025-02-01", "2025-02-28", "2025-03-25", "2025-03-25"},
+		// 5月25日は日曜日
+		{3, "2025-04-01", "2025-04-30", "2025-05-25", "2025-05-23"},
+		// 翌年1月25日は日曜日
+		{11, "2025-12-01", "2025-12-31", "2026-01-25", "2026-01-23"},
+	}
+
+	for _, test := range tests {
+		e := schedule.Entries[test.index]
+		got := []string{
+			e.PeriodStart.Format("2006-01-02"),
+			e.ClosingDate.Format("2006-01-02"),
+			e.ScheduledPaymentDate.Format("2006-01-02"),
+			e.PaymentDate.Format("2006-01-02"),
+		}
+		expected := []string{test.start, test.closing, test.scheduled, test.payment}
+		for i := range expected {
+			if got[i] != expected[i] {
+				t.Errorf("Entry %d: Expected %v, got %v", test.index, expected, got)
+				break
+			}
+		}
+		if e.Shifted != (test.scheduled != test.payment) {
+			t.Errorf("Entry %d: Unexpected shifted flag %v", test.index, e.Shifted)
+		}
+	}
+}
+
+func TestSettlementService_GetSettlementSchedule_SameMonthAndNextShift(t *testing.T) {
+	service := NewSettlementService(NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{}))
+
+	// 20日締め当月末払い、休日なら翌営業日
+	rule := domain.SettlementRule{ClosingDay: 20, PaymentMonthOffset: 0, PaymentDay: 0, Shift: domain.SettlementShiftNext}
+	schedule, err := service.GetSettlementSchedule(2025, rule, domain.BusinessDayOptions{})
+	if err != nil {
+		t.Fatalf("GetSettlementSchedule should not return error: %v", err)
+	}
+
+	jan := schedule.Entries[0]
+	if jan.PeriodStart.Format("2006-01-02") != "2024-12-21" || jan.ClosingDate.Format("2006-01-02") != "2025-01-20" {
+		t.Errorf("Unexpected January period: %s - %s", jan.PeriodStart.Format("2006-01-02"), jan.ClosingDate.Format("2006-01-02"))
+	}
+
+	// 5月31日は土曜日のため翌営業日の6月2日
+	may := schedule.Entries[4]
+	if may.PaymentDate.Format("2006-01-02") != "2025-06-02" || !may.Shifted {
+		t.Errorf("Expected May payment on 2025-06-02, got %s", may.PaymentDate.Format("2006-01-02"))
+	}
+}
+
+func TestSettlementService_GetSettlementSchedule_DefaultShift(t *testing.T) {
+	service := NewSettlementService(NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{}))
+
+	schedule, err := service.GetSettlementSchedule(2025, domain.SettlementRule{PaymentMonthOffset: 1, PaymentDay: 10}, domain.BusinessDayOptions{})
+	if err != nil {
+		t.Fatalf("GetSettlementSchedule should not return error: %v", err)
+	}
+	if schedule.Rule.Shift != domain.SettlementShiftPrevious {
+		t.Errorf("Expected default shift %q, got %q", domain.SettlementShiftPrevious, schedule.Rule.Shift)
+	}
+}
+
+func TestSettlementService_GetSettlementSchedule_InvalidRule(t *testing.T) {
+	service := NewSettlementService(NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{}))
+
+	tests := []struct {
+		name string
+		rule domain.SettlementRule
+	}{
+		{"closing day out of range", domain.SettlementRule{ClosingDay: 32, PaymentMonthOffset: 1, PaymentDay: 25}},
+		{"negative offset", domain.SettlementRule{ClosingDay: 0, PaymentMonthOffset: -1, PaymentDay: 25}},
+		{"unknown shift", domain.SettlementRule{ClosingDay: 0, PaymentMonthOffset: 1, PaymentDay: 25, Shift: "later"}},
+		{"payment before closing", domain.SettlementRule{ClosingDay: 20, PaymentMonthOffset: 0, PaymentDay: 10}},
+	}
+
+	for _, test := range tests {
+		if _, err := service.GetSettlementSchedule(2025, test.rule, domain.BusinessDayOptions{}); err != domain.ErrInvalidInput {
+			t.Errorf("%s: Expected ErrInvalidInput, got %v", test.name, err)
+		}
+	}
+}
+
+func TestDayOfMonth(t *testing.T) {
+	tests := []struct {
+		year     int
+		month    time.Month
+		day      int
+		expected string
+	}{
+		{2025, time.February, 0, "2025-02-28"},
+		{2024, time.February, 31, "2024-02-29"},
+		{2025, time.April, 15, "2025-04-15"},
+		{2025, 0, 20, "2024-12-20"},
+		{2025, 13, 0, "2026-01-31"},
+	}
+
+	for _, test := range tests {
+		got := dayOfMonth(test.year, test.month, test.day).Format("2006-01-02")
+		if got != test.expected {
+			t.Errorf("dayOfMonth(%d, %d, %d): Expected %s, got %s", test.year, test.month, test.day, test.expected, got)
+		}
+	}
+}
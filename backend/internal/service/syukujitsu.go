@@ -0,0 +1,191 @@
+package service
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+// 内閣府の syukujitsu.csv で振替休日・国民の休日・特例の休日に使われる名称
+const (
+	officialSubstituteName = "休日"
+	officialSpecialName    = "休日（祝日扱い）"
+)
+
+// LoadSyukujitsuFile 内閣府公表の syukujitsu.csv（Shift_JIS）を読み込む
+func LoadSyukujitsuFile(path string) ([]domain.Holiday, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ParseSyukujitsuCSV(f)
+}
+
+// ParseSyukujitsuCSV syukujitsu.csv 形式（Shift_JIS、「2025/1/1,元日」の行）を解析
+// 先頭のヘッダー行と空行は読み飛ばす
+func ParseSyukujitsuCSV(r io.Reader) ([]domain.Holiday, error) {
+	reader := csv.NewReader(transform.NewReader(r, japanese.ShiftJIS.NewDecoder()))
+	reader.FieldsPerRecord = -1
+
+	holidays := []domain.Holiday{}
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(record) < 2 || strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+
+		date, err := time.Parse("2006/1/2", strings.TrimSpace(record[0]))
+		if err != nil {
+			if line == 1 {
+				continue
+			}
+			return nil, fmt.Errorf("syukujitsu.csv line %d: %w", line, err)
+		}
+
+		holidays = append(holidays, domain.Holiday{
+			Date:   date,
+			Name:   strings.TrimSpace(record[1]),
+			Type:   domain.HolidayTypeNational,
+			Source: domain.HolidaySourceOfficial,
+		})
+	}
+
+	return holidays, nil
+}
+
+// officialHolidays 年ごとに分けた公表祝日データ
+type officialHolidays struct {
+	byYear   map[int][]domain.Holiday
+	from, to int
+}
+
+func newOfficialHolidays(holidays []domain.Holiday) *officialHolidays {
+	o := &officialHolidays{byYear: make(map[int][]domain.Holiday)}
+	for _, h := range holidays {
+		year := h.Date.Year()
+		o.byYear[year] = append(o.byYear[year], h)
+		if o.from == 0 || year < o.from {
+			o.from = year
+		}
+		if year > o.to {
+			o.to = year
+		}
+	}
+	for _, list := range o.byYear {
+		sort.Slice(list, func(i, j int) bool {
+			return list[i].Date.Before(list[j].Date)
+		})
+	}
+	return o
+}
+
+// covers 公表データの収録範囲内の年かどうか
+func (o *officialHolidays) covers(year int) bool {
+	return o != nil && len(o.byYear) > 0 && year >= o.from && year <= o.to
+}
+
+// SetOfficialHolidays 内閣府公表の祝日データを設定
+// 収録範囲内の年はこのデータを正とし、範囲外の年は祝日法に基づいて計算する
+func (s *CalendarService) SetOfficialHolidays(holidays []domain.Holiday) {
+	s.official = newOfficialHolidays(holidays)
+}
+
+// OfficialHolidayRange 公表祝日データの収録範囲（年）
+func (s *CalendarService) OfficialHolidayRange() (from, to int, ok bool) {
+	if s.official == nil || len(s.official.byYear) == 0 {
+		return 0, 0, false
+	}
+	return s.official.from, s.official.to, true
+}
+
+// officialHolidaysOf 公表データによる指定年の祝日一覧
+// 振替休日などの「休日」は、計算結果と日付が一致すれば計算側の名称と算出方法を使う
+func (s *CalendarService) officialHolidaysOf(year int, computed []domain.Holiday) []domain.Holiday {
+	computedMap := make(map[string]domain.Holiday)
+	for _, h := range computed {
+		computedMap[h.Date.Format("2006-01-02")] = h
+	}
+
+	holidays := make([]domain.Holiday, 0, len(s.official.byYear[year]))
+	for _, h := range s.official.byYear[year] {
+		if c, ok := computedMap[h.Date.Format("2006-01-02")]; ok {
+			h.Method = c.Method
+			if h.Name != c.Name && officialNameMatches(h.Name, c) {
+				h.Name = c.Name
+			}
+		}
+		holidays = append(holidays, h)
+	}
+
+	return holidays
+}
+
+// DiffOfficialHolidays 指定年について、計算した祝日と公表データの差異を取得
+// 公表データが未設定、または収録範囲外の年の場合は ErrNotFound を返す
+func (s *CalendarService) DiffOfficialHolidays(year int) ([]domain.HolidayDiff, error) {
+	if err := validateYear(year); err != nil {
+		return nil, err
+	}
+	if !s.official.covers(year) {
+		return nil, domain.ErrNotFound
+	}
+
+	computed := s.computeHolidays(year)
+	computedMap := make(map[string]domain.Holiday)
+	for _, h := range computed {
+		computedMap[h.Date.Format("2006-01-02")] = h
+	}
+	officialSet := make(map[string]bool)
+
+	diffs := []domain.HolidayDiff{}
+	for _, h := range s.official.byYear[year] {
+		key := h.Date.Format("2006-01-02")
+		officialSet[key] = true
+
+		c, ok := computedMap[key]
+		switch {
+		case !ok:
+			diffs = append(diffs, domain.HolidayDiff{Date: h.Date, Official: h.Name, Type: domain.HolidayDiffMissingComputed})
+		case h.Name != c.Name && !officialNameMatches(h.Name, c):
+			diffs = append(diffs, domain.HolidayDiff{Date: h.Date, Official: h.Name, Computed: c.Name, Type: domain.HolidayDiffNameMismatch})
+		}
+	}
+	for _, c := range computed {
+		if !officialSet[c.Date.Format("2006-01-02")] {
+			diffs = append(diffs, domain.HolidayDiff{Date: c.Date, Computed: c.Name, Type: domain.HolidayDiffMissingOfficial})
+		}
+	}
+
+	sort.SliceStable(diffs, func(i, j int) bool {
+		return diffs[i].Date.Before(diffs[j].Date)
+	})
+
+	return diffs, nil
+}
+
+// officialNameMatches 公表データの総称的な名称（「休日」など）が計算した祝日に対応するか
+func officialNameMatches(name string, computed domain.Holiday) bool {
+	switch name {
+	case officialSubstituteName:
+		return computed.Method == domain.HolidayMethodSubstitute || computed.Method == domain.HolidayMethodCitizens
+	case officialSpecialName:
+		return computed.Method == domain.HolidayMethodSpecial
+	}
+	return false
+}
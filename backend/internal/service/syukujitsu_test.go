@@ -0,0 +1,190 @@
+package service
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/ryohighbridge/learn-github-copilot/backend/internal/domain"
+	"golang.org/x/text/encoding/japanese"
+)
+
+// shiftJIS テスト用に UTF-8 の文字列を Shift_JIS に変換
+func shiftJIS(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := japanese.ShiftJIS.NewEncoder().Bytes([]byte(s))
+	if err != nil {
+		t.Fatalf("Failed to encode Shift_JIS: %v", err)
+	}
+	return b
+}
+
+const syukujitsuCSV = "国民の祝日・休日月日,国民の祝日・休日名称\r\n" +
+	"2019/1/1,元日\r\n" +
+	"2019/1/14,成人の日\r\n" +
+	"2019/2/11,建国記念の日\r\n" +
+	"2019/3/21,春分の日\r\n" +
+	"2019/4/29,昭和の日\r\n" +
+	"2019/4/30,休日\r\n" +
+	"2019/5/1,休日（祝日扱い）\r\n" +
+	"2019/5/2,休日\r\n" +
+	"2019/5/3,憲法記念日\r\n" +
+	"2019/5/4,みどりの日\r\n" +
+	"2019/5/5,こどもの日\r\n" +
+	"2019/5/6,休日\r\n" +
+	"2019/7/15,海の日\r\n" +
+	"2019/8/11,山の日\r\n" +
+	"2019/8/12,休日\r\n" +
+	"2019/9/16,敬老の日\r\n" +
+	"2019/9/23,秋分の日\r\n" +
+	"2019/10/14,体育の日（スポーツの日）\r\n" +
+	"2019/10/22,休日（祝日扱い）\r\n" +
+	"2019/11/3,文化の日\r\n" +
+	"2019/11/4,休日\r\n" +
+	"2019/11/23,勤労感謝の日\r\n" +
+	"2020/1/1,元日\r\n"
+
+func TestParseSyukujitsuCSV(t *testing.T) {
+	holidays, err := ParseSyukujitsuCSV(bytes.NewReader(shiftJIS(t, syukujitsuCSV)))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(holidays) != 23 {
+		t.Fatalf("Expected 23 holidays, got %d", len(holidays))
+	}
+	if !holidays[0].Date.Equal(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)) || holidays[0].Name != "元日" {
+		t.Errorf("Expected 2019-01-01 元日, got %v %s", holidays[0].Date, holidays[0].Name)
+	}
+	if holidays[6].Name != "休日（祝日扱い）" {
+		t.Errorf("Expected Shift_JIS name to be decoded, got %q", holidays[6].Name)
+	}
+	if holidays[0].Source != domain.HolidaySourceOfficial {
+		t.Errorf("Expected source %q, got %q", domain.HolidaySourceOfficial, holidays[0].Source)
+	}
+}
+
+func TestParseSyukujitsuCSV_InvalidDate(t *testing.T) {
+	data := shiftJIS(t, "国民の祝日・休日月日,国民の祝日・休日名称\r\n2019/1/1,元日\r\n2019-02-11,建国記念の日\r\n")
+
+	if _, err := ParseSyukujitsuCSV(bytes.NewReader(data)); err == nil {
+		t.Error("Expected error for invalid date")
+	}
+}
+
+func newOfficialCalendarService(t *testing.T) *CalendarService {
+	t.Helper()
+	holidays, err := ParseSyukujitsuCSV(bytes.NewReader(shiftJIS(t, syukujitsuCSV)))
+	if err != nil {
+		t.Fatalf("Failed to parse CSV: %v", err)
+	}
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+	service.SetOfficialHolidays(holidays)
+	return service
+}
+
+func TestCalendarService_GetHolidays_Official(t *testing.T) {
+	service := newOfficialCalendarService(t)
+
+	from, to, ok := service.OfficialHolidayRange()
+	if !ok || from != 2019 || to != 2020 {
+		t.Errorf("Expected range 2019-2020, got %d-%d (%v)", from, to, ok)
+	}
+
+	holidays := service.GetHolidays(2019)
+	if len(holidays) != 22 {
+		t.Errorf("Expected 22 official holidays in 2019, got %d", len(holidays))
+	}
+	for _, h := range holidays {
+		if h.Source != domain.HolidaySourceOfficial {
+			t.Errorf("%s: Expected source %q, got %q", h.Date.Format("2006-01-02"), domain.HolidaySourceOfficial, h.Source)
+		}
+	}
+
+	tests := []struct {
+		month, day int
+		expected   string
+		method     string
+	}{
+		// 公表データの名称を使う
+		{10, 14, "体育の日（スポーツの日）", domain.HolidayMethodHappyMonday},
+		// 「休日」は計算結果と一致すれば計算側の名称を使う
+		{5, 6, "振替休日", domain.HolidayMethodSubstitute},
+		{4, 30, "国民の休日", domain.HolidayMethodCitizens},
+		{10, 22, "即位礼正殿の儀", domain.HolidayMethodSpecial},
+	}
+
+	for _, test := range tests {
+		for _, h := range holidays {
+			if h.Date.Month() != time.Month(test.month) || h.Date.Day() != test.day {
+				continue
+			}
+			if h.Name != test.expected || h.Method != test.method {
+				t.Errorf("2019-%02d-%02d: Expected %s (%s), got %s (%s)", test.month, test.day, test.expected, test.method, h.Name, h.Method)
+			}
+		}
+	}
+
+	// 収録範囲外の年は計算にフォールバック
+	computed := service.GetHolidays(2025)
+	if len(computed) == 0 || computed[0].Source != domain.HolidaySourceComputed {
+		t.Error("Expected computed holidays outside the official range")
+	}
+}
+
+func TestCalendarService_DiffOfficialHolidays(t *testing.T) {
+	service := newOfficialCalendarService(t)
+
+	diffs, err := service.DiffOfficialHolidays(2019)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// テスト用データでは12月23日以降と天皇誕生日がないため、計算結果のみの祝日は検出されない
+	expected := []domain.HolidayDiff{
+		{Date: time.Date(2019, 10, 14, 0, 0, 0, 0, time.UTC), Official: "体育の日（スポーツの日）", Computed: "体育の日", Type: domain.HolidayDiffNameMismatch},
+	}
+	if len(diffs) != len(expected) {
+		t.Fatalf("Expected %d diffs, got %d: %v", len(expected), len(diffs), diffs)
+	}
+	for i := range expected {
+		if diffs[i] != expected[i] {
+			t.Errorf("Expected diff %v, got %v", expected[i], diffs[i])
+		}
+	}
+
+	if _, err := service.DiffOfficialHolidays(2025); err != domain.ErrNotFound {
+		t.Errorf("Expected ErrNotFound outside the official range, got %v", err)
+	}
+	if _, err := service.DiffOfficialHolidays(3000); err != domain.ErrInvalidInput {
+		t.Errorf("Expected ErrInvalidInput for unsupported year, got %v", err)
+	}
+	if _, err := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{}).DiffOfficialHolidays(2019); err != domain.ErrNotFound {
+		t.Errorf("Expected ErrNotFound without official data, got %v", err)
+	}
+}
+
+func TestCalendarService_DiffOfficialHolidays_Missing(t *testing.T) {
+	service := NewCalendarService(&MockCustomHolidayRepository{}, &MockEventRepository{})
+	service.SetOfficialHolidays([]domain.Holiday{
+		{Date: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Name: "元日", Source: domain.HolidaySourceOfficial},
+		{Date: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), Name: "休日", Source: domain.HolidaySourceOfficial},
+	})
+
+	diffs, err := service.DiffOfficialHolidays(2024)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(diffs) == 0 || diffs[0].Type != domain.HolidayDiffMissingComputed || diffs[0].Official != "休日" {
+		t.Fatalf("Expected first diff to be missing_computed 休日, got %v", diffs)
+	}
+	for _, d := range diffs[1:] {
+		if d.Type != domain.HolidayDiffMissingOfficial {
+			t.Errorf("Expected missing_official, got %v", d)
+		}
+	}
+	if len(diffs) != len(service.computeHolidays(2024)) {
+		t.Errorf("Expected %d diffs, got %d", len(service.computeHolidays(2024)), len(diffs))
+	}
+}
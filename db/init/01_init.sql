@@ -6,25 +6,53 @@ CREATE TABLE IF NOT EXISTS schema_migrations (
     PRIMARY KEY (version)
 );
 
--- 初期マイグレーション（000001）を適用済みとしてマーク
-INSERT INTO schema_migrations (version, dirty) VALUES (1, false)
+-- 初期マイグレーション（000001〜000007）を適用済みとしてマーク
+INSERT INTO schema_migrations (version, dirty) VALUES (7, false)
 ON CONFLICT (version) DO NOTHING;
 
+-- イベントの全文検索（トライグラム）
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- 検索用の正規化（NFKC で全角英数字・半角カナを揃え、ひらがなをカタカナ、英字を小文字にする）
+-- バックエンドの normalizeSearchText と同じ規則とする
+CREATE OR REPLACE FUNCTION normalize_search_text(value TEXT) RETURNS TEXT
+LANGUAGE SQL IMMUTABLE PARALLEL SAFE AS $$
+    SELECT lower(translate(normalize(COALESCE(value, ''), NFKC),
+        'ぁあぃいぅうぇえぉおかがきぎくぐけげこごさざしじすずせぜそぞただちぢっつづてでとどなにぬねのはばぱひびぴふぶぷへべぺほぼぽまみむめもゃやゅゆょよらりるれろゎわゐゑをんゔゕゖゝゞ',
+        'ァアィイゥウェエォオカガキギクグケゲコゴサザシジスズセゼソゾタダチヂッツヅテデトドナニヌネノハバパヒビピフブプヘベペホボポマミムメモャヤュユョヨラリルレロヮワヰヱヲンヴヵヶヽヾ'))
+$$;
+
 -- イベントテーブル
 CREATE TABLE IF NOT EXISTS events (
     id SERIAL PRIMARY KEY,
     title VARCHAR(255) NOT NULL,
     description TEXT,
-    start_date TIMESTAMP NOT NULL,
-    end_date TIMESTAMP NOT NULL,
+    start_date TIMESTAMPTZ NOT NULL,
+    end_date TIMESTAMPTZ NOT NULL,
     all_day BOOLEAN DEFAULT FALSE,
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    time_zone VARCHAR(64) NOT NULL DEFAULT 'Asia/Tokyo',
+    rrule TEXT NOT NULL DEFAULT '',
+    exdates TIMESTAMPTZ[] NOT NULL DEFAULT '{}',
+    rdates TIMESTAMPTZ[] NOT NULL DEFAULT '{}',
+    recurring_event_id INTEGER REFERENCES events(id) ON DELETE CASCADE,
+    recurrence_id TIMESTAMPTZ,
+    business_rule JSONB,
+    search_title TEXT GENERATED ALWAYS AS (normalize_search_text(title)) STORED,
+    search_description TEXT GENERATED ALWAYS AS (normalize_search_text(description)) STORED,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
 );
 
 -- インデックス
 CREATE INDEX IF NOT EXISTS idx_events_start_date ON events(start_date);
 CREATE INDEX IF NOT EXISTS idx_events_end_date ON events(end_date);
+CREATE INDEX IF NOT EXISTS idx_events_recurring_event_id ON events(recurring_event_id);
+CREATE INDEX IF NOT EXISTS idx_events_start_date_id ON events(start_date, id);
+CREATE INDEX IF NOT EXISTS idx_events_updated_at_id ON events(updated_at, id);
+CREATE INDEX IF NOT EXISTS idx_events_title_id ON events(title, id);
+CREATE INDEX IF NOT EXISTS idx_events_title_pattern ON events(title text_pattern_ops);
+CREATE INDEX IF NOT EXISTS idx_events_search_title ON events USING GIN (search_title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_events_search_description ON events USING GIN (search_description gin_trgm_ops);
 
 -- 更新日時の自動更新トリガー
 CREATE OR REPLACE FUNCTION update_updated_at_column()
@@ -37,3 +65,21 @@ $$ language 'plpgsql';
 
 CREATE TRIGGER update_events_updated_at BEFORE UPDATE ON events
 FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- 独自休日テーブル（創立記念日、年末年始休業など）
+CREATE TABLE IF NOT EXISTS custom_holidays (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    recurring BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT custom_holidays_date_range CHECK (end_date >= start_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_custom_holidays_start_date ON custom_holidays(start_date);
+
+CREATE TRIGGER update_custom_holidays_updated_at BEFORE UPDATE ON custom_holidays
+FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,8 @@
+-- トリガーの削除
+DROP TRIGGER IF EXISTS update_custom_holidays_updated_at ON custom_holidays;
+
+-- インデックスの削除
+DROP INDEX IF EXISTS idx_custom_holidays_start_date;
+
+-- テーブルの削除
+DROP TABLE IF EXISTS custom_holidays;
@@ -0,0 +1,19 @@
+-- 独自休日テーブル（創立記念日、年末年始休業など）
+CREATE TABLE IF NOT EXISTS custom_holidays (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    recurring BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT custom_holidays_date_range CHECK (end_date >= start_date)
+);
+
+-- インデックス
+CREATE INDEX IF NOT EXISTS idx_custom_holidays_start_date ON custom_holidays(start_date);
+
+-- 更新日時の自動更新トリガー（関数は000001で作成済み）
+CREATE TRIGGER update_custom_holidays_updated_at BEFORE UPDATE ON custom_holidays
+FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
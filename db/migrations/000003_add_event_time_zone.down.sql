@@ -0,0 +1,9 @@
+-- タイムゾーン列の削除
+ALTER TABLE events DROP COLUMN IF EXISTS time_zone;
+
+-- イベントの日時をタイムゾーンなし（日本時間）に戻す
+ALTER TABLE events
+    ALTER COLUMN start_date TYPE TIMESTAMP USING start_date AT TIME ZONE 'Asia/Tokyo',
+    ALTER COLUMN end_date TYPE TIMESTAMP USING end_date AT TIME ZONE 'Asia/Tokyo',
+    ALTER COLUMN created_at TYPE TIMESTAMP USING created_at AT TIME ZONE 'UTC',
+    ALTER COLUMN updated_at TYPE TIMESTAMP USING updated_at AT TIME ZONE 'UTC';
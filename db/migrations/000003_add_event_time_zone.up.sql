@@ -0,0 +1,10 @@
+-- イベントの日時をタイムゾーン付きに変更
+-- 既存の日時はタイムゾーンなしで保存されているため、日本時間として解釈する
+ALTER TABLE events
+    ALTER COLUMN start_date TYPE TIMESTAMPTZ USING start_date AT TIME ZONE 'Asia/Tokyo',
+    ALTER COLUMN end_date TYPE TIMESTAMPTZ USING end_date AT TIME ZONE 'Asia/Tokyo',
+    ALTER COLUMN created_at TYPE TIMESTAMPTZ USING created_at AT TIME ZONE 'UTC',
+    ALTER COLUMN updated_at TYPE TIMESTAMPTZ USING updated_at AT TIME ZONE 'UTC';
+
+-- イベントのタイムゾーン（IANAタイムゾーン名）
+ALTER TABLE events ADD COLUMN IF NOT EXISTS time_zone VARCHAR(64) NOT NULL DEFAULT 'Asia/Tokyo';
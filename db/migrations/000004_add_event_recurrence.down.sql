@@ -0,0 +1,9 @@
+-- 繰り返しイベントの列の削除
+DROP INDEX IF EXISTS idx_events_recurring_event_id;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS recurrence_id,
+    DROP COLUMN IF EXISTS recurring_event_id,
+    DROP COLUMN IF EXISTS rdates,
+    DROP COLUMN IF EXISTS exdates,
+    DROP COLUMN IF EXISTS rrule;
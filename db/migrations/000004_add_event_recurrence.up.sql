@@ -0,0 +1,10 @@
+-- 繰り返しイベント（RFC 5545 の RRULE・EXDATE・RDATE）
+ALTER TABLE events
+    ADD COLUMN IF NOT EXISTS rrule TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS exdates TIMESTAMPTZ[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS rdates TIMESTAMPTZ[] NOT NULL DEFAULT '{}',
+    -- 「この回のみ」の変更で切り離した回の元のシリーズと、その回の本来の開始日時
+    ADD COLUMN IF NOT EXISTS recurring_event_id INTEGER REFERENCES events(id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS recurrence_id TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_events_recurring_event_id ON events(recurring_event_id);
@@ -0,0 +1,3 @@
+-- 営業日に基づく繰り返しの列の削除
+ALTER TABLE events
+    DROP COLUMN IF EXISTS business_rule;
@@ -0,0 +1,3 @@
+-- 営業日に基づく繰り返し（月末営業日・第N営業日、休業日の前後への移動）
+ALTER TABLE events
+    ADD COLUMN IF NOT EXISTS business_rule JSONB;
@@ -0,0 +1,5 @@
+-- イベント一覧のインデックスの削除
+DROP INDEX IF EXISTS idx_events_title_pattern;
+DROP INDEX IF EXISTS idx_events_title_id;
+DROP INDEX IF EXISTS idx_events_updated_at_id;
+DROP INDEX IF EXISTS idx_events_start_date_id;
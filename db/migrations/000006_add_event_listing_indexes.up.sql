@@ -0,0 +1,5 @@
+-- イベント一覧の並び替え（キーセットページネーション）とタイトルの前方一致検索のインデックス
+CREATE INDEX IF NOT EXISTS idx_events_start_date_id ON events(start_date, id);
+CREATE INDEX IF NOT EXISTS idx_events_updated_at_id ON events(updated_at, id);
+CREATE INDEX IF NOT EXISTS idx_events_title_id ON events(title, id);
+CREATE INDEX IF NOT EXISTS idx_events_title_pattern ON events(title text_pattern_ops);
@@ -0,0 +1,9 @@
+-- イベントの全文検索の列とインデックスの削除
+DROP INDEX IF EXISTS idx_events_search_description;
+DROP INDEX IF EXISTS idx_events_search_title;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS search_description,
+    DROP COLUMN IF EXISTS search_title;
+
+DROP FUNCTION IF EXISTS normalize_search_text(TEXT);
@@ -0,0 +1,18 @@
+-- イベントの全文検索（トライグラム）
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- 検索用の正規化（NFKC で全角英数字・半角カナを揃え、ひらがなをカタカナ、英字を小文字にする）
+-- バックエンドの normalizeSearchText と同じ規則とする
+CREATE OR REPLACE FUNCTION normalize_search_text(value TEXT) RETURNS TEXT
+LANGUAGE SQL IMMUTABLE PARALLEL SAFE AS $$
+    SELECT lower(translate(normalize(COALESCE(value, ''), NFKC),
+        'ぁあぃいぅうぇえぉおかがきぎくぐけげこごさざしじすずせぜそぞただちぢっつづてでとどなにぬねのはばぱひびぴふぶぷへべぺほぼぽまみむめもゃやゅゆょよらりるれろゎわゐゑをんゔゕゖゝゞ',
+        'ァアィイゥウェエォオカガキギクグケゲコゴサザシジスズセゼソゾタダチヂッツヅテデトドナニヌネノハバパヒビピフブプヘベペホボポマミムメモャヤュユョヨラリルレロヮワヰヱヲンヴヵヶヽヾ'))
+$$;
+
+ALTER TABLE events
+    ADD COLUMN IF NOT EXISTS search_title TEXT GENERATED ALWAYS AS (normalize_search_text(title)) STORED,
+    ADD COLUMN IF NOT EXISTS search_description TEXT GENERATED ALWAYS AS (normalize_search_text(description)) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_events_search_title ON events USING GIN (search_title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_events_search_description ON events USING GIN (search_description gin_trgm_ops);
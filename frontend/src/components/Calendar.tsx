@@ -10,7 +10,7 @@ export default function Calendar() {
 
   useEffect(() => {
     fetchCalendar(currentYear, currentMonth)
-    fetchEvents()
+    fetchEvents(currentYear, currentMonth)
   }, [currentYear, currentMonth, fetchCalendar, fetchEvents])
 
   return (
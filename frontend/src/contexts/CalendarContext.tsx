@@ -1,6 +1,7 @@
 'use client'
 
 import React, { createContext, useContext, useState, useCallback } from 'react'
+import { format } from 'date-fns'
 import { CalendarData, Event } from '@/types/calendar'
 import { api } from '@/lib/api'
 
@@ -13,7 +14,7 @@ interface CalendarContextType {
   error: string | null
   setCurrentDate: (year: number, month: number) => void
   fetchCalendar: (year: number, month: number) => Promise<void>
-  fetchEvents: () => Promise<void>
+  fetchEvents: (year: number, month: number) => Promise<void>
   createEvent: (event: Omit<Event, 'id' | 'created_at' | 'updated_at'>) => Promise<void>
   updateEvent: (id: number, event: Omit<Event, 'id' | 'created_at' | 'updated_at'>) => Promise<void>
   deleteEvent: (id: number) => Promise<void>
@@ -51,9 +52,14 @@ export function CalendarProvider({ children }: { children: React.ReactNode }) {
     }
   }, [])
 
-  const fetchEvents = useCallback(async () => {
+  // 表示している月のイベントを期間指定で取得する（繰り返しイベントは各回に展開され、件数で打ち切られない）
+  const fetchEvents = useCallback(async (year: number, month: number) => {
     try {
-      const data = await api.getEvents()
+      const data = await api.getEvents({
+        start: format(new Date(year, month - 1, 1), 'yyyy-MM-dd'),
+        end: format(new Date(year, month, 0), 'yyyy-MM-dd'),
+        tz: Intl.DateTimeFormat().resolvedOptions().timeZone,
+      })
       setEvents(data)
     } catch (err) {
       console.error('Failed to fetch events:', err)
@@ -63,7 +69,7 @@ export function CalendarProvider({ children }: { children: React.ReactNode }) {
   const createEvent = useCallback(async (event: Omit<Event, 'id' | 'created_at' | 'updated_at'>) => {
     try {
       await api.createEvent(event)
-      await fetchEvents()
+      await fetchEvents(currentYear, currentMonth)
       await fetchCalendar(currentYear, currentMonth)
     } catch (err) {
       throw new Error('イベントの作成に失敗しました')
@@ -73,7 +79,7 @@ export function CalendarProvider({ children }: { children: React.ReactNode }) {
   const updateEvent = useCallback(async (id: number, event: Omit<Event, 'id' | 'created_at' | 'updated_at'>) => {
     try {
       await api.updateEvent(id, event)
-      await fetchEvents()
+      await fetchEvents(currentYear, currentMonth)
       await fetchCalendar(currentYear, currentMonth)
     } catch (err) {
       throw new Error('イベントの更新に失敗しました')
@@ -83,7 +89,7 @@ export function CalendarProvider({ children }: { children: React.ReactNode }) {
   const deleteEvent = useCallback(async (id: number) => {
     try {
       await api.deleteEvent(id)
-      await fetchEvents()
+      await fetchEvents(currentYear, currentMonth)
       await fetchCalendar(currentYear, currentMonth)
     } catch (err) {
       throw new Error('イベントの削除に失敗しました')
@@ -1,5 +1,16 @@
+import type { EventBusinessRule, EventEditScope, EventSearchResult } from '@/types/calendar'
+
 const API_BASE_URL = process.env.NEXT_PUBLIC_API_URL || 'http://localhost:8080'
 
+// 繰り返しイベントの変更範囲のクエリ文字列
+function eventScopeQuery(occurrence?: { scope: EventEditScope; recurrence_id: string }) {
+  if (!occurrence || occurrence.scope === 'all') {
+    return ''
+  }
+  const params = new URLSearchParams({ scope: occurrence.scope, recurrence_id: occurrence.recurrence_id })
+  return `?${params.toString()}`
+}
+
 export const api = {
   async getCalendar(year: number, month: number) {
     const response = await fetch(`${API_BASE_URL}/api/calendar/${year}/${month}`)
@@ -9,6 +20,15 @@ export const api = {
     return response.json()
   },
 
+  // 祝日を取得できる国・地域コードの一覧
+  async getHolidayRegions(): Promise<string[]> {
+    const response = await fetch(`${API_BASE_URL}/api/holidays/regions`)
+    if (!response.ok) {
+      throw new Error('Failed to fetch holiday regions')
+    }
+    return response.json()
+  },
+
   async getHolidays(year: number) {
     const response = await fetch(`${API_BASE_URL}/api/holidays/${year}`)
     if (!response.ok) {
@@ -17,11 +37,51 @@ export const api = {
     return response.json()
   },
 
-  async getEvents() {
-    const response = await fetch(`${API_BASE_URL}/api/events`)
+  // range を指定した場合は期間と重なるイベント（繰り返しイベントは各回）をすべて取得
+  // 省略した場合は開始日時順の最初の1ページ（100件）のみのため、続きは listEvents の next をたどる
+  async getEvents(range?: { start: string; end: string; tz?: string }) {
+    const query = range ? `?${new URLSearchParams(range).toString()}` : ''
+    const response = await fetch(`${API_BASE_URL}/api/events${query}`)
+    if (!response.ok) {
+      throw new Error('Failed to fetch events')
+    }
+    return response.json()
+  },
+
+  // 並び替え・絞り込みを指定してイベントを1ページ取得（next は次のページのURL、最後のページでは null）
+  async listEvents(params: {
+    sort?: string
+    limit?: number
+    cursor?: string
+    all_day?: boolean
+    updated_since?: string
+    title_prefix?: string
+    tz?: string
+  } = {}) {
+    const query = new URLSearchParams()
+    Object.entries(params).forEach(([key, value]) => {
+      if (value !== undefined) {
+        query.set(key, String(value))
+      }
+    })
+    const response = await fetch(`${API_BASE_URL}/api/events?${query.toString()}`)
     if (!response.ok) {
       throw new Error('Failed to fetch events')
     }
+    const next = response.headers.get('Link')?.match(/<([^>]+)>;\s*rel="next"/)?.[1]
+    return { events: await response.json(), next: next ? `${API_BASE_URL}${next}` : null }
+  },
+
+  // タイトル・説明の検索（highlights は <mark> で囲んだHTMLエスケープ済みの文字列）
+  async searchEvents(q: string, limit?: number): Promise<EventSearchResult[]> {
+    const params = new URLSearchParams({ q })
+    if (limit !== undefined) {
+      params.set('limit', String(limit))
+    }
+    const response = await fetch(`${API_BASE_URL}/api/events/search?${params.toString()}`)
+    if (!response.ok) {
+      throw new Error('Failed to search events')
+    }
     return response.json()
   },
 
@@ -31,6 +91,11 @@ export const api = {
     start_date: string
     end_date: string
     all_day: boolean
+    time_zone?: string
+    rrule?: string
+    exdates?: string[]
+    rdates?: string[]
+    business_rule?: EventBusinessRule
   }) {
     const response = await fetch(`${API_BASE_URL}/api/events`, {
       method: 'POST',
@@ -51,8 +116,13 @@ export const api = {
     start_date: string
     end_date: string
     all_day: boolean
-  }) {
-    const response = await fetch(`${API_BASE_URL}/api/events/${id}`, {
+    time_zone?: string
+    rrule?: string
+    exdates?: string[]
+    rdates?: string[]
+    business_rule?: EventBusinessRule
+  }, occurrence?: { scope: EventEditScope; recurrence_id: string }) {
+    const response = await fetch(`${API_BASE_URL}/api/events/${id}${eventScopeQuery(occurrence)}`, {
       method: 'PUT',
       headers: {
         'Content-Type': 'application/json',
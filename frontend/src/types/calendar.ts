@@ -5,27 +5,213 @@ export interface Event {
   start_date: string
   end_date: string
   all_day: boolean
+  time_zone: string
+  rrule?: string
+  exdates?: string[]
+  rdates?: string[]
+  recurring_event_id?: number
+  recurrence_id?: string
+  business_rule?: EventBusinessRule
   created_at: string
   updated_at: string
 }
 
+export type EventEditScope = 'this' | 'following' | 'all'
+
+export interface EventSearchResult {
+  event: Event
+  score: number
+  highlights: {
+    title: string
+    description?: string
+  }
+}
+
+export interface EventBusinessRule {
+  business_day?: number
+  shift?: 'previous' | 'next' | 'none'
+  weekend?: number[]
+  regions?: string[]
+  include_custom?: boolean
+}
+
+export interface CalendarEvent extends Event {
+  position: 'single' | 'start' | 'middle' | 'end'
+}
+
 export interface CalendarDay {
   date: string
   day: number
   weekday: string
   is_holiday: boolean
   holiday?: string
+  holidays: Holiday[]
   rokuyo: string
-  events: Event[]
+  lunar: LunarDate
+  moon_age: number
+  moon_phase: string
+  seasonal_days: SeasonalDay[]
+  eto: string
+  senjitsu: string[]
+  events: CalendarEvent[]
+  outside_month?: boolean
+}
+
+export interface CalendarWeek {
+  iso_year: number
+  iso_week: number
+  days: CalendarDay[]
+}
+
+export interface SeasonalDay {
+  date: string
+  name: string
+  type: 'solar_term' | 'zassetsu'
+}
+
+export interface LunarDate {
+  year: number
+  month: number
+  day: number
+  is_leap_month: boolean
 }
 
 export interface CalendarData {
   year: number
   month: number
+  eras: EraYear[]
   days: CalendarDay[]
+  weeks?: CalendarWeek[]
+}
+
+export interface CalendarYear {
+  year: number
+  eras: EraYear[]
+  months: CalendarData[]
+}
+
+export interface EraYear {
+  era: string
+  year: number
+  label: string
 }
 
 export interface Holiday {
   date: string
   name: string
+  type: 'national' | 'custom'
+  region?: string
+  method?: string
+  source?: 'official' | 'computed'
+}
+
+export interface CustomHoliday {
+  id: number
+  name: string
+  description: string
+  start_date: string
+  end_date: string
+  recurring: boolean
+  created_at: string
+  updated_at: string
+}
+
+export interface Rekichu {
+  date: string
+  name: string
+  eto: string
+}
+
+export interface BusinessDay {
+  date: string
+  is_business_day: boolean
+  is_weekend: boolean
+  holidays: Holiday[]
+}
+
+export interface BusinessDayShift {
+  from: string
+  days: number
+  date: string
+}
+
+export interface BusinessDayCount {
+  start: string
+  end: string
+  business_days: number
+  calendar_days: number
+}
+
+export interface DateInfo {
+  date: string
+  weekday: string
+  rokuyo: string
+  is_holiday: boolean
+  holidays: Holiday[]
+  is_weekend: boolean
+  is_business_day: boolean
+}
+
+export interface SettlementRule {
+  closing_day: number
+  payment_month_offset: number
+  payment_day: number
+  shift: 'previous' | 'next' | 'none'
+}
+
+export interface SettlementEntry {
+  period_start: string
+  closing_date: string
+  scheduled_payment_date: string
+  payment_date: string
+  shifted: boolean
+}
+
+export interface SettlementSchedule {
+  year: number
+  rule: SettlementRule
+  entries: SettlementEntry[]
+}
+
+export interface FiscalTermDefinition {
+  name: string
+  start_month: number
+  start_day: number
+}
+
+export interface FiscalYearDefinition {
+  id: string
+  name: string
+  start_month: number
+  terms: FiscalTermDefinition[]
+}
+
+export interface FiscalPeriod {
+  name: string
+  start: string
+  end: string
+}
+
+export interface FiscalDate {
+  date: string
+  definition: string
+  fiscal_year: number
+  name: string
+  half: number
+  quarter: number
+  fiscal_month: number
+  year: FiscalPeriod
+  quarter_span: FiscalPeriod
+  term?: FiscalPeriod
+}
+
+export interface FiscalYearCalendar {
+  definition: string
+  fiscal_year: number
+  name: string
+  start: string
+  end: string
+  quarters: FiscalPeriod[]
+  terms: FiscalPeriod[]
+  months: CalendarData[]
 }